@@ -38,6 +38,7 @@ import (
 	"knative.dev/pkg/injection"
 	revisioninformer "knative.dev/serving/pkg/client/injection/informers/serving/v1/revision"
 
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"knative.dev/pkg/configmap"
@@ -50,7 +51,7 @@ import (
 	"knative.dev/pkg/profiling"
 	"knative.dev/pkg/signals"
 	"knative.dev/pkg/system"
-	"knative.dev/pkg/tracing"
+	pkgtracing "knative.dev/pkg/tracing"
 	tracingconfig "knative.dev/pkg/tracing/config"
 	"knative.dev/pkg/version"
 	"knative.dev/pkg/websocket"
@@ -58,11 +59,13 @@ import (
 	activatorhandler "knative.dev/serving/pkg/activator/handler"
 	activatornet "knative.dev/serving/pkg/activator/net"
 	"knative.dev/serving/pkg/apis/networking"
+	"knative.dev/serving/pkg/autoscaler/bucket"
 	asmetrics "knative.dev/serving/pkg/autoscaler/metrics"
 	pkghttp "knative.dev/serving/pkg/http"
 	"knative.dev/serving/pkg/logging"
 	"knative.dev/serving/pkg/network"
 	"knative.dev/serving/pkg/queue"
+	"knative.dev/serving/pkg/tracing"
 )
 
 const (
@@ -89,21 +92,69 @@ var (
 	kubeconfig = flag.String("kubeconfig", "", "Path to a kubeconfig. Only required if out-of-cluster.")
 )
 
-func statReporter(statSink *websocket.ManagedConnection, stopCh <-chan struct{},
+// statSinks routes every stat message to the WebSocket connection of the
+// autoscaler bucket owning its revision.
+type statSinks struct {
+	// bs is nil when a single autoscaler replica serves all revisions.
+	bs    *bucket.BucketSet
+	sinks map[string]*websocket.ManagedConnection
+}
+
+func newStatSinks(bucketCount uint32, logger *zap.SugaredLogger) *statSinks {
+	s := &statSinks{sinks: make(map[string]*websocket.ManagedConnection, bucketCount)}
+	if bucketCount <= 1 {
+		// Single autoscaler replica, keep talking to the well-known Service.
+		endpoint := fmt.Sprintf("ws://%s.%s.svc.%s%s", "autoscaler", system.Namespace(), pkgnet.GetClusterDomainName(), autoscalerPort)
+		logger.Info("Connecting to Autoscaler at ", endpoint)
+		s.sinks[""] = websocket.NewDurableSendingConnection(endpoint, logger)
+		return s
+	}
+	s.bs = bucket.AutoscalerBucketSet(bucketCount)
+	for _, bkt := range s.bs.BucketList() {
+		endpoint := fmt.Sprintf("ws://%s.%s.svc.%s%s", bkt, system.Namespace(), pkgnet.GetClusterDomainName(), autoscalerPort)
+		logger.Info("Connecting to Autoscaler bucket at ", endpoint)
+		s.sinks[bkt] = websocket.NewDurableSendingConnection(endpoint, logger)
+	}
+	return s
+}
+
+func (s *statSinks) sinkFor(key types.NamespacedName) *websocket.ManagedConnection {
+	if s.bs == nil {
+		return s.sinks[""]
+	}
+	return s.sinks[s.bs.Owner(key.String())]
+}
+
+func (s *statSinks) status() error {
+	for _, sink := range s.sinks {
+		if err := sink.Status(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *statSinks) shutdown() {
+	for _, sink := range s.sinks {
+		sink.Shutdown()
+	}
+}
+
+func statReporter(sinks *statSinks, stopCh <-chan struct{},
 	statChan <-chan []asmetrics.StatMessage, logger *zap.SugaredLogger) {
 	for {
 		select {
 		case sm := <-statChan:
 			go func() {
 				for _, msg := range sm {
-					if err := statSink.Send(msg); err != nil {
+					if err := sinks.sinkFor(msg.Key).Send(msg); err != nil {
 						logger.Errorw("Error while sending stat", zap.Error(err))
 					}
 				}
 			}()
 		case <-stopCh:
 			// It's a sending connection, so no drainage required.
-			statSink.Shutdown()
+			sinks.shutdown()
 			return
 		}
 	}
@@ -112,6 +163,11 @@ func statReporter(statSink *websocket.ManagedConnection, stopCh <-chan struct{},
 type config struct {
 	PodName string `split_words:"true" required:"true"`
 	PodIP   string `split_words:"true" required:"true"`
+
+	// AutoscalerBucketCount is the number of buckets the autoscaler
+	// deployment is sharded into. It must match the autoscaler's own
+	// setting for stats to reach the right shard.
+	AutoscalerBucketCount uint32 `split_words:"true" default:"1"`
 }
 
 func main() {
@@ -189,7 +245,7 @@ func main() {
 		net.JoinHostPort(env.PodIP, strconv.Itoa(networking.BackendHTTPPort)))
 	go throttler.Run(ctx)
 
-	oct := tracing.NewOpenCensusTracer(tracing.WithExporter(networking.ActivatorServiceName, logger))
+	oct := pkgtracing.NewOpenCensusTracer(pkgtracing.WithExporter(networking.ActivatorServiceName, logger))
 
 	tracerUpdater := configmap.TypeFilter(&tracingconfig.Config{})(func(name string, value interface{}) {
 		cfg := value.(*tracingconfig.Config)
@@ -199,16 +255,18 @@ func main() {
 		}
 	})
 
+	lbPolicyUpdater := configmap.TypeFilter(&network.Config{})(func(name string, value interface{}) {
+		throttler.UpdateLBPolicy(value.(*network.Config).ActivatorLBPolicy)
+	})
+
 	// Set up our config store
 	configMapWatcher := configmap.NewInformedWatcher(kubeClient, system.Namespace())
-	configStore := activatorconfig.NewStore(logger, tracerUpdater)
+	configStore := activatorconfig.NewStore(logger, tracerUpdater, lbPolicyUpdater)
 	configStore.WatchConfigs(configMapWatcher)
 
-	// Open a WebSocket connection to the autoscaler.
-	autoscalerEndpoint := fmt.Sprintf("ws://%s.%s.svc.%s%s", "autoscaler", system.Namespace(), pkgnet.GetClusterDomainName(), autoscalerPort)
-	logger.Info("Connecting to Autoscaler at ", autoscalerEndpoint)
-	statSink := websocket.NewDurableSendingConnection(autoscalerEndpoint, logger)
-	go statReporter(statSink, ctx.Done(), statCh, logger)
+	// Open WebSocket connections to the autoscaler shards.
+	statSinks := newStatSinks(env.AutoscalerBucketCount, logger)
+	go statReporter(statSinks, ctx.Done(), statCh, logger)
 
 	// Create and run our concurrency reporter
 	cr := activatorhandler.NewConcurrencyReporter(ctx, env.PodName, reqCh, statCh)
@@ -218,6 +276,9 @@ func main() {
 	// Note: innermost handlers are specified first, ie. the last handler in the chain will be executed first
 	var ah http.Handler = activatorhandler.New(ctx, throttler)
 	ah = activatorhandler.NewRequestEventHandler(reqCh, ah)
+	// The authorization check runs before the request is counted or
+	// proxied, so unauthorized traffic cannot trigger a cold start.
+	ah = activatorhandler.NewAuthHandler(ah)
 	ah = tracing.HTTPSpanMiddleware(ah)
 	ah = configStore.HTTPMiddleware(ah)
 	reqLogHandler, err := pkghttp.NewRequestLogHandler(ah, logging.NewSyncFileWriter(os.Stdout), "",
@@ -231,6 +292,9 @@ func main() {
 	// the healthchecks or probes.
 	ah = activatorhandler.NewMetricHandler(env.PodName, ah)
 	ah = activatorhandler.NewContextHandler(ctx, ah)
+	// Assign a request id before anything is logged or traced, so the
+	// same id shows up in the activator's, queue-proxy's and app's logs.
+	ah = network.NewRequestIDHandler(ah)
 
 	// Network probe handlers.
 	ah = &activatorhandler.ProbeHandler{NextHandler: ah}
@@ -238,7 +302,7 @@ func main() {
 
 	// Set up our health check based on the health of stat sink and environmental factors.
 	sigCtx, sigCancel := context.WithCancel(context.Background())
-	hc := newHealthCheck(sigCtx, logger, statSink)
+	hc := newHealthCheck(sigCtx, logger, statSinks)
 	ah = &activatorhandler.HealthHandler{HealthCheck: hc, NextHandler: ah, Logger: logger}
 
 	profilingHandler := profiling.NewHandler(logger, false)
@@ -295,7 +359,7 @@ func main() {
 	logger.Info("Servers shutdown.")
 }
 
-func newHealthCheck(sigCtx context.Context, logger *zap.SugaredLogger, statSink *websocket.ManagedConnection) func() error {
+func newHealthCheck(sigCtx context.Context, logger *zap.SugaredLogger, statSinks *statSinks) func() error {
 	once := sync.Once{}
 	return func() error {
 		select {
@@ -307,7 +371,7 @@ func newHealthCheck(sigCtx context.Context, logger *zap.SugaredLogger, statSink
 			return errors.New("received SIGTERM from kubelet")
 		default:
 			logger.Debug("No signal yet.")
-			return statSink.Status()
+			return statSinks.status()
 		}
 	}
 }
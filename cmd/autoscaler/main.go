@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"os"
 	"time"
 
 	basecmd "github.com/kubernetes-incubator/custom-metrics-apiserver/pkg/cmd"
@@ -36,7 +37,6 @@ import (
 	"k8s.io/client-go/rest"
 
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
-	endpointsinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/endpoints"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection"
@@ -49,9 +49,17 @@ import (
 	"knative.dev/pkg/version"
 	av1alpha1 "knative.dev/serving/pkg/apis/autoscaling/v1alpha1"
 	"knative.dev/serving/pkg/apis/serving"
+	"knative.dev/serving/pkg/autoscaler/bucket"
 	asmetrics "knative.dev/serving/pkg/autoscaler/metrics"
 	"knative.dev/serving/pkg/autoscaler/scaling"
+	"knative.dev/serving/pkg/autoscaler/statforwarder"
 	"knative.dev/serving/pkg/autoscaler/statserver"
+	metricinformer "knative.dev/serving/pkg/client/injection/informers/autoscaling/v1alpha1/metric"
+	painformer "knative.dev/serving/pkg/client/injection/informers/autoscaling/v1alpha1/podautoscaler"
+	endpointsinformer "knative.dev/serving/pkg/client/injection/kube/informers/filtered/core/v1/endpoints"
+	"knative.dev/serving/pkg/health"
+	servinginjection "knative.dev/serving/pkg/injection"
+	"knative.dev/serving/pkg/leaderelection"
 	smetrics "knative.dev/serving/pkg/metrics"
 	"knative.dev/serving/pkg/reconciler/autoscaling/kpa"
 	"knative.dev/serving/pkg/reconciler/metric"
@@ -59,10 +67,17 @@ import (
 )
 
 const (
-	statsServerAddr = ":8080"
-	statsBufferLen  = 1000
-	component       = "autoscaler"
-	controllerNum   = 2
+	statsServerAddr  = ":8080"
+	debugServerAddr  = ":8081"
+	healthServerAddr = ":8090"
+	statsBufferLen   = 1000
+	component        = "autoscaler"
+	controllerNum    = 2
+
+	// meshCompatibilityModeEnvName is the environment variable selecting
+	// how the scrapers reach the Revision pods, e.g. forcing scraping via
+	// the K8S services on clusters whose mesh enforces strict mTLS.
+	meshCompatibilityModeEnvName = "MESH_COMPATIBILITY_MODE"
 )
 
 var (
@@ -78,6 +93,9 @@ func main() {
 
 	// Set up signals so we handle the first shutdown signal gracefully.
 	ctx := signals.NewContext()
+	// Optionally restrict all informers to a single namespace, for
+	// deployments without cluster-wide RBAC.
+	ctx = servinginjection.ScopeFromEnv(ctx)
 
 	// Report stats on Go memory usage every 30 seconds.
 	msp := metrics.NewMemStatsAll()
@@ -139,25 +157,82 @@ func main() {
 
 	endpointsInformer := endpointsinformer.Get(ctx)
 
-	collector := asmetrics.NewMetricCollector(statsScraperFactoryFunc(endpointsInformer.Lister()), logger)
+	meshMode, err := asmetrics.ParseMeshCompatibilityMode(os.Getenv(meshCompatibilityModeEnvName))
+	if err != nil {
+		logger.Fatalw("Failed to parse the mesh compatibility mode", zap.Error(err))
+	}
+	collector := asmetrics.NewMetricCollector(statsScraperFactoryFunc(endpointsInformer.Lister(), meshMode), logger)
 	customMetricsAdapter.WithCustomMetrics(asmetrics.NewMetricProvider(collector))
 
 	// Set up scalers.
 	// uniScalerFactory depends endpointsInformer to be set.
 	multiScaler := scaling.NewMultiScaler(ctx.Done(), uniScalerFactoryFunc(endpointsInformer, collector), logger)
 
-	controllers := []*controller.Impl{
-		kpa.NewController(ctx, cmw, multiScaler),
-		metric.NewController(ctx, cmw, collector),
+	kpaCtl := kpa.NewController(ctx, cmw, multiScaler)
+	metricCtl := metric.NewController(ctx, cmw, collector)
+	controllers := []*controller.Impl{kpaCtl, metricCtl}
+
+	// When running more than one replica, Revisions are partitioned into
+	// lease-based buckets, each owned by a single replica. Stats received
+	// for a bucket owned by another replica are forwarded to it.
+	bucketCount, err := bucket.Count()
+	if err != nil {
+		logger.Fatalw("Failed to read the bucket count", zap.Error(err))
+	}
+	statsServerCh := statsCh
+	if bucketCount > 1 {
+		selfIP, err := bucket.PodIP()
+		if err != nil {
+			logger.Fatalw("Failed to determine the pod IP", zap.Error(err))
+		}
+		forwarder := statforwarder.New(ctx, logger, kubeClient, selfIP,
+			bucket.AutoscalerBucketSet(bucketCount),
+			func(sm asmetrics.StatMessage) { statsCh <- sm })
+		defer forwarder.Cancel()
+
+		// Every replica runs all controllers, so gate the reconcilers on
+		// the same bucket ownership as the stats to avoid conflicting
+		// scale and SKS writes, and re-enqueue everything whenever a
+		// bucket changes hands so the new owner takes over its keys.
+		elector := forwarder.Elector()
+		kpaCtl.Reconciler = leaderelection.Gate(elector, kpaCtl.Reconciler)
+		metricCtl.Reconciler = leaderelection.Gate(elector, metricCtl.Reconciler)
+		paInformer := painformer.Get(ctx)
+		metricInformer := metricinformer.Get(ctx)
+		elector.Subscribe(func(string, string) {
+			kpaCtl.GlobalResync(paInformer.Informer())
+			metricCtl.GlobalResync(metricInformer.Informer())
+		})
+
+		forwardCh := make(chan asmetrics.StatMessage, statsBufferLen)
+		defer close(forwardCh)
+		go func() {
+			for sm := range forwardCh {
+				forwarder.Process(sm)
+			}
+		}()
+		statsServerCh = forwardCh
 	}
 
 	// Set up a statserver.
-	statsServer := statserver.New(statsServerAddr, statsCh, logger)
+	statsServer := statserver.New(statsServerAddr, statsServerCh, logger)
+
+	// Expose the deciders' state for debugging scaling decisions.
+	debugMux := http.NewServeMux()
+	debugMux.Handle("/debug/deciders", scaling.NewDebugHandler(multiScaler, collector))
+	debugServer := &http.Server{Addr: debugServerAddr, Handler: debugMux}
+
+	// Serve liveness and readiness, the latter gated on informer sync
+	// and config load.
+	healthProbe := health.NewProbe()
+	healthProbe.TrackInformers(informers...)
+	healthServer := health.NewServer(healthServerAddr, healthProbe)
 
 	// Start watching the configs.
 	if err := cmw.Start(ctx.Done()); err != nil {
 		logger.Fatalw("Failed to start watching configs", zap.Error(err))
 	}
+	healthProbe.MarkConfigsLoaded()
 
 	// Start all of the informers and wait for them to sync.
 	if err := controller.StartInformers(ctx.Done(), informers...); err != nil {
@@ -180,6 +255,8 @@ func main() {
 		return customMetricsAdapter.Run(ctx.Done())
 	})
 	eg.Go(statsServer.ListenAndServe)
+	eg.Go(debugServer.ListenAndServe)
+	eg.Go(healthServer.ListenAndServe)
 	eg.Go(profilingServer.ListenAndServe)
 
 	// This will block until either a signal arrives or one of the grouped functions
@@ -187,6 +264,8 @@ func main() {
 	<-egCtx.Done()
 
 	statsServer.Shutdown(5 * time.Second)
+	debugServer.Shutdown(context.Background())
+	healthServer.Shutdown(context.Background())
 	profilingServer.Shutdown(context.Background())
 	// Don't forward ErrServerClosed as that indicates we're already shutting down.
 	if err := eg.Wait(); err != nil && err != http.ErrServerClosed {
@@ -217,11 +296,13 @@ func uniScalerFactoryFunc(endpointsInformer corev1informers.EndpointsInformer,
 	}
 }
 
-func statsScraperFactoryFunc(endpointsLister corev1listers.EndpointsLister) asmetrics.StatsScraperFactory {
+func statsScraperFactoryFunc(endpointsLister corev1listers.EndpointsLister, meshMode asmetrics.MeshCompatibilityMode) asmetrics.StatsScraperFactory {
 	return func(metric *av1alpha1.Metric) (asmetrics.StatsScraper, error) {
 		podCounter := resources.NewScopedEndpointsCounter(
 			endpointsLister, metric.Namespace, metric.Spec.ScrapeTarget)
-		return asmetrics.NewServiceScraper(metric, podCounter)
+		podAddresses := resources.NewScopedEndpointsAddressGetter(
+			endpointsLister, metric.Namespace, metric.Spec.ScrapeTarget)
+		return asmetrics.NewServiceScraper(metric, podCounter, podAddresses, meshMode)
 	}
 }
 
@@ -17,27 +17,124 @@ limitations under the License.
 package main
 
 import (
+	"context"
+	"log"
+	"net/http"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/rest"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/injection/sharedmain"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/metrics"
+	"knative.dev/pkg/profiling"
+	"knative.dev/pkg/signals"
+	"knative.dev/serving/pkg/health"
+	servinginjection "knative.dev/serving/pkg/injection"
+
 	// The set of controllers this controller process runs.
 	"knative.dev/serving/pkg/reconciler/configuration"
+	"knative.dev/serving/pkg/reconciler/domainmapping"
 	"knative.dev/serving/pkg/reconciler/gc"
 	"knative.dev/serving/pkg/reconciler/labeler"
 	"knative.dev/serving/pkg/reconciler/revision"
 	"knative.dev/serving/pkg/reconciler/route"
 	"knative.dev/serving/pkg/reconciler/serverlessservice"
 	"knative.dev/serving/pkg/reconciler/service"
+)
 
-	// This defines the shared main for injected controllers.
-	"knative.dev/pkg/injection/sharedmain"
+const (
+	component = "controller"
+
+	// healthServerAddr serves liveness on /healthz and readiness on
+	// /readyz, the latter gated on informer sync and config load.
+	healthServerAddr = ":8090"
 )
 
+var ctors = []injection.ControllerConstructor{
+	configuration.NewController,
+	domainmapping.NewController,
+	labeler.NewController,
+	revision.NewController,
+	route.NewController,
+	serverlessservice.NewController,
+	service.NewController,
+	gc.NewController,
+}
+
+// main mirrors sharedmain.Main, additionally serving a health endpoint
+// that reports per-informer sync state and config store load status.
 func main() {
-	sharedmain.Main("controller",
-		configuration.NewController,
-		labeler.NewController,
-		revision.NewController,
-		route.NewController,
-		serverlessservice.NewController,
-		service.NewController,
-		gc.NewController,
-	)
+	// Set up signals so we handle the first shutdown signal gracefully.
+	ctx := signals.NewContext()
+	// Optionally restrict all informers to a single namespace, for
+	// deployments without cluster-wide RBAC.
+	ctx = servinginjection.ScopeFromEnv(ctx)
+	cfg := sharedmain.ParseAndGetConfigOrDie()
+
+	log.Printf("Registering %d clients", len(injection.Default.GetClients()))
+	log.Printf("Registering %d informer factories", len(injection.Default.GetInformerFactories()))
+	log.Printf("Registering %d informers", len(injection.Default.GetInformers()))
+	log.Printf("Registering %d controllers", len(ctors))
+
+	sharedmain.MemStatsOrDie(ctx)
+
+	// Adjust our client's rate limits based on the number of controllers we are running.
+	cfg.QPS = float32(len(ctors)) * rest.DefaultQPS
+	cfg.Burst = len(ctors) * rest.DefaultBurst
+
+	ctx, informers := injection.Default.SetupInformers(ctx, cfg)
+
+	logger, atomicLevel := sharedmain.SetupLoggerOrDie(ctx, component)
+	defer flush(logger)
+	ctx = logging.WithLogger(ctx, logger)
+	profilingHandler := profiling.NewHandler(logger, false)
+
+	healthProbe := health.NewProbe()
+	healthProbe.TrackInformers(informers...)
+
+	sharedmain.CheckK8sClientMinimumVersionOrDie(ctx, logger)
+	cmw := sharedmain.SetupConfigMapWatchOrDie(ctx, logger)
+	controllers, _ := sharedmain.ControllersAndWebhooksFromCtors(ctx, cmw, ctors...)
+	sharedmain.WatchLoggingConfigOrDie(ctx, cmw, logger, atomicLevel, component)
+	sharedmain.WatchObservabilityConfigOrDie(ctx, cmw, profilingHandler, logger, component)
+
+	healthServer := health.NewServer(healthServerAddr, healthProbe)
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.Go(healthServer.ListenAndServe)
+
+	logger.Info("Starting configuration manager...")
+	if err := cmw.Start(ctx.Done()); err != nil {
+		logger.Fatalw("Failed to start configuration manager", zap.Error(err))
+	}
+	healthProbe.MarkConfigsLoaded()
+
+	logger.Info("Starting informers...")
+	if err := controller.StartInformers(ctx.Done(), informers...); err != nil {
+		logger.Fatalw("Failed to start informers", zap.Error(err))
+	}
+	logger.Info("Starting controllers...")
+	go controller.StartAll(ctx.Done(), controllers...)
+
+	profilingServer := profiling.NewServer(profilingHandler)
+	eg.Go(profilingServer.ListenAndServe)
+
+	// This will block until either a signal arrives or one of the grouped functions
+	// returns an error.
+	<-egCtx.Done()
+
+	healthServer.Shutdown(context.Background())
+	profilingServer.Shutdown(context.Background())
+	// Don't forward ErrServerClosed as that indicates we're already shutting down.
+	if err := eg.Wait(); err != nil && err != http.ErrServerClosed {
+		logger.Errorw("Error while running server", zap.Error(err))
+	}
+}
+
+func flush(logger *zap.SugaredLogger) {
+	logger.Sync()
+	metrics.FlushExporter()
 }
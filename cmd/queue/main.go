@@ -33,7 +33,6 @@ import (
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
-	"go.opencensus.io/plugin/ochttp"
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/trace"
 	"go.uber.org/zap"
@@ -47,17 +46,19 @@ import (
 	pkgnet "knative.dev/pkg/network"
 	"knative.dev/pkg/profiling"
 	"knative.dev/pkg/signals"
-	"knative.dev/pkg/tracing"
+	pkgtracing "knative.dev/pkg/tracing"
 	tracingconfig "knative.dev/pkg/tracing/config"
 	"knative.dev/serving/pkg/activator"
 	activatorutil "knative.dev/serving/pkg/activator/util"
 	"knative.dev/serving/pkg/apis/networking"
+	"knative.dev/serving/pkg/apis/serving"
 	pkghttp "knative.dev/serving/pkg/http"
 	"knative.dev/serving/pkg/logging"
 	"knative.dev/serving/pkg/network"
 	"knative.dev/serving/pkg/queue"
 	"knative.dev/serving/pkg/queue/health"
 	"knative.dev/serving/pkg/queue/readiness"
+	"knative.dev/serving/pkg/tracing"
 )
 
 const (
@@ -67,7 +68,7 @@ const (
 	// Duration the /quitquitquit handler should wait before returning.
 	// This is to give Istio a little bit more time to remove the pod
 	// from its configuration and propagate that to all istio-proxies
-	// in the mesh.
+	// in the mesh. Used unless DRAIN_TIMEOUT_SECONDS overrides it.
 	quitSleepDuration = 20 * time.Second
 
 	badProbeTemplate   = "unexpected probe header value: %s"
@@ -80,6 +81,10 @@ const (
 	aggressivePollInterval = 25 * time.Millisecond
 	// reportingPeriod is the interval of time between reporting stats by queue proxy.
 	reportingPeriod = 1 * time.Second
+
+	// retryAfterSeconds is the value of the Retry-After header on requests
+	// rejected under the retry-after rejection policy.
+	retryAfterSeconds = 1
 )
 
 var (
@@ -89,12 +94,18 @@ var (
 )
 
 type config struct {
-	ContainerConcurrency   int    `split_words:"true" required:"true"`
-	QueueServingPort       int    `split_words:"true" required:"true"`
-	UserPort               int    `split_words:"true" required:"true"`
-	RevisionTimeoutSeconds int    `split_words:"true" required:"true"`
-	ServingReadinessProbe  string `split_words:"true" required:"true"`
-	EnableProfiling        bool   `split_words:"true"` // optional
+	ContainerConcurrency                int    `split_words:"true" required:"true"`
+	QueueServingPort                    int    `split_words:"true" required:"true"`
+	UserPort                            int    `split_words:"true" required:"true"`
+	UserPortProtocol                    string `split_words:"true"` // optional
+	RevisionTimeoutSeconds              int    `split_words:"true" required:"true"`
+	RevisionResponseStartTimeoutSeconds int    `split_words:"true"` // optional
+	RevisionIdleTimeoutSeconds          int    `split_words:"true"` // optional
+	DrainTimeoutSeconds                 int    `split_words:"true"` // optional
+	ServingReadinessProbe               string `split_words:"true" required:"true"`
+	EnableProfiling                     bool   `split_words:"true"` // optional
+	ConcurrencyStateEndpoint            string `split_words:"true"` // optional
+	RejectionPolicy                     string `split_words:"true"` // optional
 
 	// Logging configuration
 	ServingLoggingConfig         string `split_words:"true" required:"true"`
@@ -128,8 +139,22 @@ type config struct {
 	TracingConfigStackdriverProjectID string                    `split_words:"true"` // optional
 }
 
+// rejectRequest answers a request the breaker could not admit, honoring the
+// Revision's rejection policy.
+func rejectRequest(w http.ResponseWriter, err error, rejectionPolicy string) {
+	switch rejectionPolicy {
+	case serving.RejectionPolicyTooManyRequests:
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+	case serving.RejectionPolicyRetryAfter:
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds))
+		fallthrough
+	default:
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+	}
+}
+
 // Make handler a closure for testing.
-func proxyHandler(reqChan chan queue.ReqEvent, breaker *queue.Breaker, tracingEnabled bool, next http.Handler) http.HandlerFunc {
+func proxyHandler(reqChan chan queue.ReqEvent, breaker *queue.Breaker, promStatReporter *queue.PrometheusStatsReporter, tracingEnabled bool, rejectionPolicy string, next http.Handler) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if network.IsKubeletProbe(r) {
 			next.ServeHTTP(w, r)
@@ -138,6 +163,7 @@ func proxyHandler(reqChan chan queue.ReqEvent, breaker *queue.Breaker, tracingEn
 
 		if tracingEnabled {
 			proxyCtx, proxySpan := trace.StartSpan(r.Context(), "proxy")
+			proxySpan.AddAttributes(trace.StringAttribute("http.request_id", r.Header.Get(network.RequestIDHeaderName)))
 			r = r.WithContext(proxyCtx)
 			defer proxySpan.End()
 		}
@@ -155,12 +181,34 @@ func proxyHandler(reqChan chan queue.ReqEvent, breaker *queue.Breaker, tracingEn
 
 		// Enforce queuing and concurrency limits.
 		if breaker != nil {
-			if err := breaker.Maybe(r.Context(), func() {
+			if promStatReporter != nil {
+				promStatReporter.ReportQueueDepth(breaker.InFlight())
+			}
+			queueStart := time.Now()
+			pass := func() {
+				if promStatReporter != nil {
+					promStatReporter.ReportQueueingLatency(time.Since(queueStart))
+				}
 				next.ServeHTTP(w, r)
-			}); err != nil {
+			}
+			err := breaker.Maybe(r.Context(), pass)
+			// The block policy waits for capacity to free up instead of
+			// rejecting, until the request's own timeout fires.
+			for err == queue.ErrRequestQueueFull && rejectionPolicy == serving.RejectionPolicyBlock {
+				select {
+				case <-r.Context().Done():
+					err = context.DeadlineExceeded
+				case <-time.After(aggressivePollInterval):
+					err = breaker.Maybe(r.Context(), pass)
+				}
+			}
+			if err != nil {
 				switch err {
 				case context.DeadlineExceeded, queue.ErrRequestQueueFull:
-					http.Error(w, err.Error(), http.StatusServiceUnavailable)
+					if promStatReporter != nil {
+						promStatReporter.ReportRejectedRequest()
+					}
+					rejectRequest(w, err, rejectionPolicy)
 				default:
 					w.WriteHeader(http.StatusInternalServerError)
 				}
@@ -373,7 +421,7 @@ func main() {
 	probe := buildProbe(env.ServingReadinessProbe)
 	healthState := &health.State{}
 
-	server := buildServer(env, healthState, probe, reqChan, logger)
+	server := buildServer(env, healthState, probe, reqChan, promStatReporter, logger)
 	adminServer := buildAdminServer(healthState)
 	metricsServer := buildMetricsServer(promStatReporter)
 
@@ -416,9 +464,13 @@ func main() {
 		os.Exit(1)
 	case <-signals.SetupSignalHandler():
 		logger.Info("Received TERM signal, attempting to gracefully shutdown servers.")
+		drainDuration := quitSleepDuration
+		if env.DrainTimeoutSeconds > 0 {
+			drainDuration = time.Duration(env.DrainTimeoutSeconds) * time.Second
+		}
 		healthState.Shutdown(func() {
 			// Give Istio time to sync our "not ready" state.
-			time.Sleep(quitSleepDuration)
+			time.Sleep(drainDuration)
 
 			// Calling server.Shutdown() allows pending requests to
 			// complete, while no new work is accepted.
@@ -462,7 +514,7 @@ func buildProbe(probeJSON string) *readiness.Probe {
 }
 
 func buildServer(env config, healthState *health.State, rp *readiness.Probe, reqChan chan queue.ReqEvent,
-	logger *zap.SugaredLogger) *http.Server {
+	promStatReporter *queue.PrometheusStatsReporter, logger *zap.SugaredLogger) *http.Server {
 	target := &url.URL{
 		Scheme: "http",
 		Host:   net.JoinHostPort("127.0.0.1", strconv.Itoa(env.UserPort)),
@@ -485,16 +537,26 @@ func buildServer(env config, healthState *health.State, rp *readiness.Probe, req
 	if metricsSupported {
 		composedHandler = requestAppMetricsHandler(composedHandler, breaker, env)
 	}
-	composedHandler = proxyHandler(reqChan, breaker, tracingEnabled, composedHandler)
+	composedHandler = proxyHandler(reqChan, breaker, promStatReporter, tracingEnabled, env.RejectionPolicy, composedHandler)
 	composedHandler = queue.ForwardedShimHandler(composedHandler)
-	composedHandler = queue.TimeToFirstByteTimeoutHandler(composedHandler,
-		time.Duration(env.RevisionTimeoutSeconds)*time.Second, "request timeout")
+	if env.ConcurrencyStateEndpoint != "" {
+		logger.Info("Concurrency state endpoint set, tracking request transitions, using endpoint: ", env.ConcurrencyStateEndpoint)
+		pause, resume := queue.ConcurrencyStateHooks(env.ConcurrencyStateEndpoint)
+		composedHandler = queue.ConcurrencyStateHandler(logger, composedHandler, pause, resume)
+	}
+	composedHandler = queue.NewTimeoutHandler(composedHandler, "request timeout",
+		time.Duration(env.RevisionTimeoutSeconds)*time.Second,
+		time.Duration(env.RevisionResponseStartTimeoutSeconds)*time.Second,
+		time.Duration(env.RevisionIdleTimeoutSeconds)*time.Second)
 	composedHandler = pushRequestLogHandler(composedHandler, env)
 
 	if metricsSupported {
 		composedHandler = requestMetricsHandler(composedHandler, env)
 	}
 	composedHandler = tracing.HTTPSpanMiddleware(composedHandler)
+	// Assign a request id before anything is logged or traced, so the
+	// same id shows up in the activator's, queue-proxy's and app's logs.
+	composedHandler = network.NewRequestIDHandler(composedHandler)
 
 	composedHandler = knativeProbeHandler(healthState, rp.ProbeContainer, rp.IsAggressive(), tracingEnabled, composedHandler, env, logger)
 	composedHandler = network.NewProbeHandler(composedHandler)
@@ -503,11 +565,18 @@ func buildServer(env config, healthState *health.State, rp *readiness.Probe, req
 }
 
 func buildTransport(env config, logger *zap.SugaredLogger) http.RoundTripper {
+	var base http.RoundTripper = pkgnet.AutoTransport
+	if env.UserPortProtocol == string(networking.ProtocolH2C) {
+		// The user container speaks HTTP/2 cleartext, so talk h2c to it
+		// unconditionally instead of downgrading HTTP/1 requests.
+		base = pkgnet.NewH2CTransport()
+	}
+
 	if env.TracingConfigBackend == tracingconfig.None {
-		return pkgnet.AutoTransport
+		return base
 	}
 
-	oct := tracing.NewOpenCensusTracer(tracing.WithExporter(env.ServingPod, logger))
+	oct := pkgtracing.NewOpenCensusTracer(pkgtracing.WithExporter(env.ServingPod, logger))
 	oct.ApplyConfig(&tracingconfig.Config{
 		Backend:              env.TracingConfigBackend,
 		Debug:                env.TracingConfigDebug,
@@ -516,9 +585,7 @@ func buildTransport(env config, logger *zap.SugaredLogger) http.RoundTripper {
 		SampleRate:           env.TracingConfigSampleRate,
 	})
 
-	return &ochttp.Transport{
-		Base: pkgnet.AutoTransport,
-	}
+	return tracing.Transport(base)
 }
 
 func buildBreaker(env config) *queue.Breaker {
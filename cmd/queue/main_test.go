@@ -38,6 +38,7 @@ import (
 	tracingconfig "knative.dev/pkg/tracing/config"
 	tracetesting "knative.dev/pkg/tracing/testing"
 	"knative.dev/serving/pkg/activator"
+	"knative.dev/serving/pkg/apis/serving"
 	"knative.dev/serving/pkg/network"
 	"knative.dev/serving/pkg/queue"
 	"knative.dev/serving/pkg/queue/health"
@@ -78,7 +79,7 @@ func TestHandlerReqEvent(t *testing.T) {
 	params := queue.BreakerParams{QueueDepth: 10, MaxConcurrency: 10, InitialCapacity: 10}
 	breaker := queue.NewBreaker(params)
 	reqChan := make(chan queue.ReqEvent, 10)
-	h := proxyHandler(reqChan, breaker, true /*tracingEnabled*/, proxy)
+	h := proxyHandler(reqChan, breaker, nil /*promStatReporter*/, true /*tracingEnabled*/, "" /*rejectionPolicy*/, proxy)
 
 	writer := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
@@ -475,7 +476,7 @@ func TestQueueTraceSpans(t *testing.T) {
 					Base: pkgnet.AutoTransport,
 				}
 
-				h := proxyHandler(reqChan, breaker, true /*tracingEnabled*/, proxy)
+				h := proxyHandler(reqChan, breaker, nil /*promStatReporter*/, true /*tracingEnabled*/, "" /*rejectionPolicy*/, proxy)
 				h(writer, req)
 			} else {
 				h := knativeProbeHandler(healthState, tc.prober, true /* isAggresive*/, true /*tracingEnabled*/, nil, config{}, logger)
@@ -507,6 +508,41 @@ func TestQueueTraceSpans(t *testing.T) {
 	}
 }
 
+func TestRejectRequest(t *testing.T) {
+	tests := []struct {
+		name           string
+		policy         string
+		wantStatus     int
+		wantRetryAfter string
+	}{{
+		name:       "default policy",
+		policy:     "",
+		wantStatus: http.StatusServiceUnavailable,
+	}, {
+		name:           "retry-after policy",
+		policy:         serving.RejectionPolicyRetryAfter,
+		wantStatus:     http.StatusServiceUnavailable,
+		wantRetryAfter: strconv.Itoa(retryAfterSeconds),
+	}, {
+		name:       "too-many-requests policy",
+		policy:     serving.RejectionPolicyTooManyRequests,
+		wantStatus: http.StatusTooManyRequests,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := httptest.NewRecorder()
+			rejectRequest(resp, queue.ErrRequestQueueFull, test.policy)
+			if got, want := resp.Code, test.wantStatus; got != want {
+				t.Errorf("StatusCode = %d, want %d", got, want)
+			}
+			if got, want := resp.Header().Get("Retry-After"), test.wantRetryAfter; got != want {
+				t.Errorf("Retry-After header = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func BenchmarkProxyHandler(b *testing.B) {
 	var baseHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
 	reqChan := make(chan queue.ReqEvent, requestCountingQueueLength)
@@ -534,7 +570,7 @@ func BenchmarkProxyHandler(b *testing.B) {
 		breaker: nil,
 	}}
 	for _, tc := range tests {
-		h := proxyHandler(reqChan, tc.breaker, true /*tracingEnabled*/, baseHandler)
+		h := proxyHandler(reqChan, tc.breaker, promStatReporter, true /*tracingEnabled*/, "" /*rejectionPolicy*/, baseHandler)
 		b.Run(fmt.Sprintf("sequential-%s", tc.label), func(b *testing.B) {
 			resp := httptest.NewRecorder()
 			for j := 0; j < b.N; j++ {
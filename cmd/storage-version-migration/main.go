@@ -0,0 +1,74 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+
+	"go.uber.org/zap"
+	apixclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"knative.dev/pkg/logging"
+	"knative.dev/serving/pkg/storageversion"
+)
+
+var (
+	masterURL  = flag.String("master", "", "The address of the Kubernetes API server. Overrides any value in kubeconfig. Only required if out-of-cluster.")
+	kubeconfig = flag.String("kubeconfig", "", "Path to a kubeconfig. Only required if out-of-cluster.")
+)
+
+const appName = "storage-version-migration"
+
+func main() {
+	flag.Parse()
+	logger := logging.FromContext(context.Background()).Named(appName)
+	defer logger.Sync()
+
+	if flag.NArg() == 0 {
+		logger.Fatal("Resources to migrate must be given as arguments, e.g. services.serving.knative.dev")
+	}
+
+	cfg, err := clientcmd.BuildConfigFromFlags(*masterURL, *kubeconfig)
+	if err != nil {
+		logger.Fatalw("Error building kubeconfig", zap.Error(err))
+	}
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		logger.Fatalw("Error building dynamic client", zap.Error(err))
+	}
+	apixClient, err := apixclient.NewForConfig(cfg)
+	if err != nil {
+		logger.Fatalw("Error building apiextensions client", zap.Error(err))
+	}
+
+	migrator := storageversion.NewMigrator(dynamicClient, apixClient)
+	for _, resource := range flag.Args() {
+		gr := schema.ParseGroupResource(resource)
+		if gr.Empty() {
+			logger.Fatalf("Unable to parse resource: %s", resource)
+		}
+		logger.Infof("Migrating %s to its storage version", gr)
+		if err := migrator.Migrate(gr); err != nil {
+			logger.Fatalw("Failed to migrate "+resource, zap.Error(err))
+		}
+	}
+	logger.Info("Migration complete")
+}
@@ -33,6 +33,7 @@ import (
 	"knative.dev/pkg/webhook/resourcesemantics/conversion"
 	"knative.dev/pkg/webhook/resourcesemantics/defaulting"
 	"knative.dev/pkg/webhook/resourcesemantics/validation"
+	servinginjection "knative.dev/serving/pkg/injection"
 
 	// resource validation types
 	autoscalingv1alpha1 "knative.dev/serving/pkg/apis/autoscaling/v1alpha1"
@@ -59,6 +60,7 @@ var types = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
 	v1alpha1.SchemeGroupVersion.WithKind("Configuration"): &v1alpha1.Configuration{},
 	v1alpha1.SchemeGroupVersion.WithKind("Route"):         &v1alpha1.Route{},
 	v1alpha1.SchemeGroupVersion.WithKind("Service"):       &v1alpha1.Service{},
+	v1alpha1.SchemeGroupVersion.WithKind("DomainMapping"): &v1alpha1.DomainMapping{},
 	v1beta1.SchemeGroupVersion.WithKind("Revision"):       &v1beta1.Revision{},
 	v1beta1.SchemeGroupVersion.WithKind("Configuration"):  &v1beta1.Configuration{},
 	v1beta1.SchemeGroupVersion.WithKind("Route"):          &v1beta1.Route{},
@@ -213,8 +215,12 @@ func NewConversionController(ctx context.Context, cmw configmap.Watcher) *contro
 }
 
 func main() {
+	// Optionally restrict all informers to a single namespace, for
+	// deployments without cluster-wide RBAC.
+	ctx := servinginjection.ScopeFromEnv(signals.NewContext())
+
 	// Set up a signal context with our webhook options
-	ctx := webhook.WithOptions(signals.NewContext(), webhook.Options{
+	ctx = webhook.WithOptions(ctx, webhook.Options{
 		ServiceName: "webhook",
 		Port:        8443,
 		SecretName:  "webhook-certs",
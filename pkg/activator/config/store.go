@@ -22,6 +22,7 @@ import (
 
 	"knative.dev/pkg/configmap"
 	tracingconfig "knative.dev/pkg/tracing/config"
+	"knative.dev/serving/pkg/network"
 )
 
 type cfgKey struct{}
@@ -29,6 +30,7 @@ type cfgKey struct{}
 // Config is a configuration for the activator
 type Config struct {
 	Tracing *tracingconfig.Config
+	Network *network.Config
 }
 
 // FromContext obtains a Config injected into the passed context
@@ -54,6 +56,7 @@ func NewStore(logger configmap.Logger, onAfterStore ...func(name string, value i
 			logger,
 			configmap.Constructors{
 				tracingconfig.ConfigName: tracingconfig.NewTracingConfigFromConfigMap,
+				network.ConfigName:       network.NewConfigFromConfigMap,
 			},
 			onAfterStore...,
 		),
@@ -69,6 +72,7 @@ func (s *Store) ToContext(ctx context.Context) context.Context {
 func (s *Store) Load() *Config {
 	return &Config{
 		Tracing: s.UntypedLoad(tracingconfig.ConfigName).(*tracingconfig.Config).DeepCopy(),
+		Network: s.UntypedLoad(network.ConfigName).(*network.Config).DeepCopy(),
 	}
 }
 
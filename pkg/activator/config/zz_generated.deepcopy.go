@@ -22,6 +22,7 @@ package config
 
 import (
 	tracingconfig "knative.dev/pkg/tracing/config"
+	network "knative.dev/serving/pkg/network"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -32,6 +33,11 @@ func (in *Config) DeepCopyInto(out *Config) {
 		*out = new(tracingconfig.Config)
 		**out = **in
 	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(network.Config)
+		**out = **in
+	}
 	return
 }
 
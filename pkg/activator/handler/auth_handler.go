@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Knative Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"knative.dev/pkg/logging"
+	pkgnet "knative.dev/pkg/network"
+	activatorconfig "knative.dev/serving/pkg/activator/config"
+)
+
+// OriginalURIHeader carries the URI of the request being authorized on
+// the check request sent to the authorization endpoint.
+const OriginalURIHeader = "X-Original-Uri"
+
+// NewAuthHandler constructs a handler that submits each request to the
+// authorization endpoint configured in config-network before the
+// activator does any work to activate the revision.  When no endpoint is
+// configured requests pass through untouched.
+func NewAuthHandler(next http.Handler) http.Handler {
+	return &authHandler{
+		transport:   pkgnet.AutoTransport,
+		nextHandler: next,
+	}
+}
+
+// authHandler rejects unauthorized requests before they reach the
+// throttler, so they can neither trigger a cold start nor count towards
+// the revision's load.
+type authHandler struct {
+	transport   http.RoundTripper
+	nextHandler http.Handler
+}
+
+func (h *authHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	authURL := activatorconfig.FromContext(r.Context()).Network.ActivatorAuthorizationURL
+	if authURL == "" {
+		h.nextHandler.ServeHTTP(w, r)
+		return
+	}
+
+	logger := logging.FromContext(r.Context())
+
+	// Mirror the original request's method and headers onto the check
+	// request, but not its body, so the authorization endpoint can make
+	// its decision without the activator buffering the payload.
+	checkReq, err := http.NewRequest(r.Method, authURL, nil)
+	if err != nil {
+		logger.Errorw("Failed to construct authorization check request", zap.Error(err))
+		http.Error(w, "authorization check failed", http.StatusInternalServerError)
+		return
+	}
+	checkReq = checkReq.WithContext(r.Context())
+	checkReq.Header = r.Header.Clone()
+	checkReq.Header.Set(OriginalURIHeader, r.RequestURI)
+
+	resp, err := h.transport.RoundTrip(checkReq)
+	if err != nil {
+		// Fail closed: without a verdict the request must not wake up pods.
+		logger.Errorw("Authorization check request failed", zap.Error(err))
+		http.Error(w, "authorization check failed", http.StatusInternalServerError)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		// Relay the authorization endpoint's verdict to the client.
+		w.WriteHeader(resp.StatusCode)
+		io.Copy(w, resp.Body)
+		return
+	}
+
+	h.nextHandler.ServeHTTP(w, r)
+}
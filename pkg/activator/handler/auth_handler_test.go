@@ -0,0 +1,130 @@
+/*
+Copyright 2020 The Knative Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ktesting "knative.dev/pkg/logging/testing"
+	"knative.dev/serving/pkg/network"
+)
+
+func TestAuthHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured bool
+		authStatus int
+		wantStatus int
+		wantPassed bool
+	}{{
+		name:       "no endpoint configured",
+		wantStatus: http.StatusOK,
+		wantPassed: true,
+	}, {
+		name:       "request authorized",
+		configured: true,
+		authStatus: http.StatusOK,
+		wantStatus: http.StatusOK,
+		wantPassed: true,
+	}, {
+		name:       "request denied",
+		configured: true,
+		authStatus: http.StatusForbidden,
+		wantStatus: http.StatusForbidden,
+		wantPassed: false,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var checkReq *http.Request
+			authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				checkReq = r.Clone(r.Context())
+				w.WriteHeader(tt.authStatus)
+			}))
+			defer authServer.Close()
+
+			authURL := ""
+			if tt.configured {
+				authURL = authServer.URL
+			}
+			configStore := setupConfigStore(t, ktesting.TestLogger(t))
+			configStore.OnConfigChanged(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: network.ConfigName},
+				Data: map[string]string{
+					network.ActivatorAuthorizationURLKey: authURL,
+				},
+			})
+
+			passed := false
+			handler := NewAuthHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				passed = true
+			}))
+
+			resp := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "http://example.com/path", nil)
+			req.Header.Set("Authorization", "Bearer a-token")
+			handler.ServeHTTP(resp, req.WithContext(configStore.ToContext(req.Context())))
+
+			if got, want := resp.Code, tt.wantStatus; got != want {
+				t.Errorf("StatusCode = %d, want %d", got, want)
+			}
+			if passed != tt.wantPassed {
+				t.Errorf("Request passed to next handler = %v, want %v", passed, tt.wantPassed)
+			}
+			if tt.configured {
+				if checkReq == nil {
+					t.Fatal("Authorization endpoint was not called")
+				}
+				if got, want := checkReq.Header.Get("Authorization"), "Bearer a-token"; got != want {
+					t.Errorf("Authorization header = %q, want %q", got, want)
+				}
+				if got, want := checkReq.Header.Get(OriginalURIHeader), "http://example.com/path"; got != want {
+					t.Errorf("%s header = %q, want %q", OriginalURIHeader, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthHandlerEndpointUnreachable(t *testing.T) {
+	authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	authServer.Close()
+
+	configStore := setupConfigStore(t, ktesting.TestLogger(t))
+	configStore.OnConfigChanged(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: network.ConfigName},
+		Data: map[string]string{
+			network.ActivatorAuthorizationURLKey: authServer.URL,
+		},
+	})
+
+	handler := NewAuthHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request must not be passed on when the authorization check fails")
+	}))
+
+	resp := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	handler.ServeHTTP(resp, req.WithContext(configStore.ToContext(req.Context())))
+
+	if got, want := resp.Code, http.StatusInternalServerError; got != want {
+		t.Errorf("StatusCode = %d, want %d", got, want)
+	}
+}
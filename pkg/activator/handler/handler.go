@@ -20,8 +20,8 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"strconv"
 
-	"go.opencensus.io/plugin/ochttp"
 	"go.opencensus.io/trace"
 	"go.uber.org/zap"
 
@@ -34,8 +34,13 @@ import (
 	"knative.dev/serving/pkg/activator/util"
 	"knative.dev/serving/pkg/network"
 	"knative.dev/serving/pkg/queue"
+	"knative.dev/serving/pkg/tracing"
 )
 
+// retryAfterSeconds is the value of the Retry-After header sent with 503
+// responses when the revision is overloaded or activation timed out.
+const retryAfterSeconds = 10
+
 // Throttler is the interface that Handler calls to Try to proxy the user request.
 type Throttler interface {
 	Try(context.Context, func(string) error) error
@@ -55,7 +60,7 @@ func New(ctx context.Context, t Throttler) http.Handler {
 	defaultTransport := pkgnet.AutoTransport
 	return &activationHandler{
 		transport:        defaultTransport,
-		tracingTransport: &ochttp.Transport{Base: defaultTransport},
+		tracingTransport: tracing.Transport(defaultTransport),
 		throttler:        t,
 		bufferPool:       network.NewBufferPool(),
 	}
@@ -68,6 +73,7 @@ func (a *activationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	tryContext, trySpan := r.Context(), (*trace.Span)(nil)
 	if tracingEnabled {
 		tryContext, trySpan = trace.StartSpan(r.Context(), "throttler_try")
+		trySpan.AddAttributes(trace.StringAttribute("http.request_id", r.Header.Get(network.RequestIDHeaderName)))
 	}
 
 	if err := a.throttler.Try(tryContext, func(dest string) error {
@@ -76,6 +82,7 @@ func (a *activationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		proxyCtx, proxySpan := r.Context(), (*trace.Span)(nil)
 		if tracingEnabled {
 			proxyCtx, proxySpan = trace.StartSpan(r.Context(), "proxy")
+			proxySpan.AddAttributes(trace.StringAttribute("http.request_id", r.Header.Get(network.RequestIDHeaderName)))
 		}
 		a.proxyRequest(logger, w, r.WithContext(proxyCtx), &url.URL{
 			Scheme: "http",
@@ -93,6 +100,9 @@ func (a *activationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 		switch err {
 		case activatornet.ErrActivatorOverload, context.DeadlineExceeded, queue.ErrRequestQueueFull:
+			// Give the client a hint when to come back, rather than have it
+			// hammer an already overloaded revision.
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds))
 			http.Error(w, err.Error(), http.StatusServiceUnavailable)
 		default:
 			w.WriteHeader(http.StatusInternalServerError)
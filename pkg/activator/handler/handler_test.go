@@ -73,14 +73,15 @@ func (ft fakeThrottler) Try(ctx context.Context, f func(string) error) error {
 
 func TestActivationHandler(t *testing.T) {
 	tests := []struct {
-		name      string
-		wantBody  string
-		wantCode  int
-		wantErr   error
-		probeErr  error
-		probeCode int
-		probeResp []string
-		throttler Throttler
+		name           string
+		wantBody       string
+		wantCode       int
+		wantErr        error
+		wantRetryAfter bool
+		probeErr       error
+		probeCode      int
+		probeResp      []string
+		throttler      Throttler
 	}{{
 		name:      "active endpoint",
 		wantBody:  wantBody,
@@ -94,17 +95,19 @@ func TestActivationHandler(t *testing.T) {
 		wantErr:   errors.New("request error"),
 		throttler: fakeThrottler{},
 	}, {
-		name:      "throttler timeout",
-		wantBody:  context.DeadlineExceeded.Error() + "\n",
-		wantCode:  http.StatusServiceUnavailable,
-		wantErr:   nil,
-		throttler: fakeThrottler{err: context.DeadlineExceeded},
+		name:           "throttler timeout",
+		wantBody:       context.DeadlineExceeded.Error() + "\n",
+		wantCode:       http.StatusServiceUnavailable,
+		wantErr:        nil,
+		wantRetryAfter: true,
+		throttler:      fakeThrottler{err: context.DeadlineExceeded},
 	}, {
-		name:      "overflow",
-		wantBody:  "activator overload\n",
-		wantCode:  http.StatusServiceUnavailable,
-		wantErr:   nil,
-		throttler: fakeThrottler{err: anet.ErrActivatorOverload},
+		name:           "overflow",
+		wantBody:       "activator overload\n",
+		wantCode:       http.StatusServiceUnavailable,
+		wantErr:        nil,
+		wantRetryAfter: true,
+		throttler:      fakeThrottler{err: anet.ErrActivatorOverload},
 	}}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -156,6 +159,10 @@ func TestActivationHandler(t *testing.T) {
 			if string(gotBody) != test.wantBody {
 				t.Errorf("Unexpected response body. Response body %q, want %q", gotBody, test.wantBody)
 			}
+
+			if gotRetryAfter := resp.Header().Get("Retry-After") != ""; gotRetryAfter != test.wantRetryAfter {
+				t.Errorf("Retry-After header presence = %v, want %v", gotRetryAfter, test.wantRetryAfter)
+			}
 		})
 	}
 }
@@ -304,6 +311,8 @@ func setupConfigStore(t *testing.T, logger *zap.SugaredLogger) *activatorconfig.
 	configStore := activatorconfig.NewStore(logger)
 	tracingConfig := ConfigMapFromTestFile(t, tracingconfig.ConfigName)
 	configStore.OnConfigChanged(tracingConfig)
+	networkConfig := ConfigMapFromTestFile(t, network.ConfigName)
+	configStore.OnConfigChanged(networkConfig)
 	return configStore
 }
 
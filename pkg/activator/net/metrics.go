@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package net
+
+import (
+	"knative.dev/serving/pkg/metrics"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+var (
+	probeTimeInMsecM = stats.Float64(
+		"probe_latencies",
+		"The time to probe a revision backend in millisecond",
+		stats.UnitMilliseconds)
+
+	// NOTE: 0 should not be used as boundary. See
+	// https://github.com/census-ecosystem/opencensus-go-exporter-stackdriver/issues/98
+	probeLatencyDistribution = view.Distribution(1, 2, 5, 10, 20, 40, 60, 80, 100, 150, 200, 300, 500, 1000, 2000, 5000)
+)
+
+func init() {
+	register()
+}
+
+func register() {
+	// Create views to see our measurements. This can return an error if
+	// a previously-registered view has the same name with a different value.
+	// View name defaults to the measure name if unspecified.
+	if err := view.Register(
+		&view.View{
+			Description: "The time to probe a revision backend in millisecond",
+			Measure:     probeTimeInMsecM,
+			Aggregation: probeLatencyDistribution,
+			TagKeys:     metrics.CommonRevisionKeys,
+		},
+	); err != nil {
+		panic(err)
+	}
+}
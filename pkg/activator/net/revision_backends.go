@@ -41,12 +41,14 @@ import (
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/logging/logkey"
+	pkgmetrics "knative.dev/pkg/metrics"
 	"knative.dev/pkg/network/prober"
 	"knative.dev/pkg/reconciler"
 	"knative.dev/serving/pkg/apis/networking"
 	"knative.dev/serving/pkg/apis/serving"
 	revisioninformer "knative.dev/serving/pkg/client/injection/informers/serving/v1/revision"
 	servinglisters "knative.dev/serving/pkg/client/listers/serving/v1"
+	smetrics "knative.dev/serving/pkg/metrics"
 	"knative.dev/serving/pkg/network"
 	"knative.dev/serving/pkg/queue"
 )
@@ -75,12 +77,15 @@ const (
 // revisionWatcher watches the podIPs and ClusterIP of the service for a revision. It implements the logic
 // to supply revisionDestsUpdate events on updateCh
 type revisionWatcher struct {
-	stopCh   <-chan struct{}
-	cancel   context.CancelFunc
-	rev      types.NamespacedName
-	protocol networking.ProtocolType
-	updateCh chan<- revisionDestsUpdate
-	done     chan struct{}
+	stopCh <-chan struct{}
+	cancel context.CancelFunc
+	rev    types.NamespacedName
+	// reporterCtx carries the metric tags of the revision, for reporting
+	// the per-probe latencies.
+	reporterCtx context.Context
+	protocol    networking.ProtocolType
+	updateCh    chan<- revisionDestsUpdate
+	done        chan struct{}
 
 	// Stores the list of pods that have been successfully probed.
 	healthyPods sets.String
@@ -97,7 +102,7 @@ type revisionWatcher struct {
 	podsAddressable bool
 }
 
-func newRevisionWatcher(ctx context.Context, rev types.NamespacedName, protocol networking.ProtocolType,
+func newRevisionWatcher(ctx, reporterCtx context.Context, rev types.NamespacedName, protocol networking.ProtocolType,
 	updateCh chan<- revisionDestsUpdate, destsCh chan dests,
 	transport http.RoundTripper, serviceLister corev1listers.ServiceLister,
 	logger *zap.SugaredLogger) *revisionWatcher {
@@ -106,6 +111,7 @@ func newRevisionWatcher(ctx context.Context, rev types.NamespacedName, protocol
 		stopCh:          ctx.Done(),
 		cancel:          cancel,
 		rev:             rev,
+		reporterCtx:     reporterCtx,
 		protocol:        protocol,
 		updateCh:        updateCh,
 		done:            make(chan struct{}),
@@ -144,6 +150,9 @@ func (rw *revisionWatcher) probe(ctx context.Context, dest string) (bool, error)
 		Host:   dest,
 		Path:   probePath,
 	}
+	defer func(start time.Time) {
+		pkgmetrics.Record(rw.reporterCtx, probeTimeInMsecM.M(float64(time.Since(start).Milliseconds())))
+	}(time.Now())
 	// NOTE: changes below may require changes to testing/roundtripper.go to make unit tests passing.
 	return prober.Do(ctx, rw.transport, httpDest.String(),
 		prober.WithHeader(network.ProbeHeaderName, queue.Name),
@@ -409,27 +418,25 @@ func (rbm *revisionBackendsManager) updates() <-chan revisionDestsUpdate {
 	return rbm.updateCh
 }
 
-func (rbm *revisionBackendsManager) getRevisionProtocol(revID types.NamespacedName) (networking.ProtocolType, error) {
-	revision, err := rbm.revisionLister.Revisions(revID.Namespace).Get(revID.Name)
-	if err != nil {
-		return "", err
-	}
-	return revision.GetProtocol(), nil
-}
-
 func (rbm *revisionBackendsManager) getOrCreateRevisionWatcher(rev types.NamespacedName) (*revisionWatcher, error) {
 	rbm.revisionWatchersMux.Lock()
 	defer rbm.revisionWatchersMux.Unlock()
 
 	rwCh, ok := rbm.revisionWatchers[rev]
 	if !ok {
-		proto, err := rbm.getRevisionProtocol(rev)
+		revision, err := rbm.revisionLister.Revisions(rev.Namespace).Get(rev.Name)
+		if err != nil {
+			return nil, err
+		}
+		reporterCtx, err := smetrics.RevisionContext(revision.Namespace,
+			revision.Labels[serving.ServiceLabelKey], revision.Labels[serving.ConfigurationLabelKey],
+			revision.Name)
 		if err != nil {
 			return nil, err
 		}
 
 		destsCh := make(chan dests)
-		rw := newRevisionWatcher(rbm.ctx, rev, proto, rbm.updateCh, destsCh, rbm.transport, rbm.serviceLister, rbm.logger)
+		rw := newRevisionWatcher(rbm.ctx, reporterCtx, rev, revision.GetProtocol(), rbm.updateCh, destsCh, rbm.transport, rbm.serviceLister, rbm.logger)
 		rbm.revisionWatchers[rev] = rw
 		go rw.run(rbm.probeFrequency)
 		return rw, nil
@@ -17,6 +17,7 @@ limitations under the License.
 package net
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"sync"
@@ -416,6 +417,7 @@ func TestRevisionWatcher(t *testing.T) {
 
 			rw := newRevisionWatcher(
 				ctx,
+				ctx, /*reporterCtx*/
 				revID,
 				tc.protocol,
 				updateCh,
@@ -776,6 +778,7 @@ func TestCheckDests(t *testing.T) {
 	uCh := make(chan revisionDestsUpdate, 1)
 	dCh := make(chan struct{})
 	rw := &revisionWatcher{
+		reporterCtx:      context.Background(),
 		clusterIPHealthy: true,
 		podsAddressable:  false,
 		rev:              types.NamespacedName{Namespace: testNamespace, Name: testRevision},
@@ -870,6 +873,7 @@ func TestCheckDestsSwinging(t *testing.T) {
 	uCh := make(chan revisionDestsUpdate, 1)
 	dCh := make(chan struct{})
 	rw := &revisionWatcher{
+		reporterCtx:     context.Background(),
 		rev:             types.NamespacedName{Namespace: testNamespace, Name: testRevision},
 		updateCh:        uCh,
 		serviceLister:   si.Lister(),
@@ -22,8 +22,10 @@ import (
 	"math"
 	"math/rand"
 	"sort"
+	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -36,14 +38,16 @@ import (
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/logging/logkey"
-	"knative.dev/pkg/network"
+	pkgnet "knative.dev/pkg/network"
 	"knative.dev/pkg/reconciler"
 	"knative.dev/pkg/system"
 	"knative.dev/serving/pkg/activator/util"
+	"knative.dev/serving/pkg/apis/autoscaling"
 	"knative.dev/serving/pkg/apis/networking"
 	v1 "knative.dev/serving/pkg/apis/serving/v1"
 	revisioninformer "knative.dev/serving/pkg/client/injection/informers/serving/v1/revision"
 	servinglisters "knative.dev/serving/pkg/client/listers/serving/v1"
+	"knative.dev/serving/pkg/network"
 	"knative.dev/serving/pkg/queue"
 	"knative.dev/serving/pkg/resources"
 )
@@ -51,8 +55,17 @@ import (
 type podTracker struct {
 	dest string
 	b    breaker
+
+	// weight is the number of requests currently in flight to this pod,
+	// maintained by the weighed load balancing policies.
+	weight int32
 }
 
+func (p *podTracker) increaseWeight() { atomic.AddInt32(&p.weight, 1) }
+func (p *podTracker) decreaseWeight() { atomic.AddInt32(&p.weight, -1) }
+
+func (p *podTracker) getWeight() int32 { return atomic.LoadInt32(&p.weight) }
+
 func (p *podTracker) Capacity() int {
 	if p.b == nil {
 		return 1
@@ -87,6 +100,11 @@ type revisionThrottler struct {
 	revID                types.NamespacedName
 	containerConcurrency int
 
+	// How long a request may wait in the breaker's queue for capacity,
+	// before it is rejected. Zero means no limit beyond the request's
+	// own context deadline.
+	activationTimeout time.Duration
+
 	// Holds the current number of backends. This is used for when we get an activatorCount update and
 	// therefore need to recalculate capacity
 	backendCount int
@@ -96,6 +114,10 @@ type revisionThrottler struct {
 	// a podIPTracker and are then called.
 	breaker breaker
 
+	// The load balancing policy used to pick a pod tracker.
+	// Guarded by mux, since it can be swapped when config-network changes.
+	lbPolicy lbPolicy
+
 	// This will be non empty when we're able to use pod addressing.
 	podTrackers []*podTracker
 
@@ -119,6 +141,7 @@ type revisionThrottler struct {
 
 func newRevisionThrottler(revID types.NamespacedName,
 	containerConcurrency int,
+	policy network.LBPolicy,
 	breakerParams queue.BreakerParams,
 	logger *zap.SugaredLogger) *revisionThrottler {
 	logger = logger.With(zap.String(logkey.Key, revID.String()))
@@ -132,22 +155,51 @@ func newRevisionThrottler(revID types.NamespacedName,
 		revID:                revID,
 		containerConcurrency: containerConcurrency,
 		breaker:              revBreaker,
+		lbPolicy:             pickLBPolicy(policy, containerConcurrency),
 		logger:               logger,
 	}
 }
 
+// pickLBPolicy resolves the configured policy name into the policy function,
+// defaulting based on the revision's container concurrency when unset.
+func pickLBPolicy(policy network.LBPolicy, containerConcurrency int) lbPolicy {
+	switch policy {
+	case network.LBPolicyFirstAvailable:
+		return firstAvailableLBPolicy
+	case network.LBPolicyRoundRobin:
+		return newRoundRobinPolicy()
+	case network.LBPolicyLeastInFlight:
+		return leastInFlightLBPolicy
+	case network.LBPolicyRandomChoice2:
+		return randomChoice2LBPolicy
+	case network.LBPolicyRandom:
+		return randomLBPolicy
+	default:
+		// Pick the first tracker with open capacity when concurrency is
+		// limited, a random one otherwise.
+		if containerConcurrency == 0 {
+			return randomLBPolicy
+		}
+		return firstAvailableLBPolicy
+	}
+}
+
 func noop() {}
 
-// pickPod picks the first tracker that has open capacity if container concurrency
-// if limited, random pod otherwise.
-func pickPod(ctx context.Context, tgs []*podTracker, cc int) (func(), *podTracker) {
-	// Infinite capacity, pick random. We have to do this
-	// otherwise _all_ the requests will go to the first pod
-	// since it has unlimited capacity.
-	if cc == 0 {
-		return noop, tgs[rand.Intn(len(tgs))]
-	}
-	for _, t := range tgs {
+// lbPolicy picks a tracker from the list to send the request to, returning
+// a callback to invoke when the request is done.
+type lbPolicy func(ctx context.Context, targets []*podTracker) (func(), *podTracker)
+
+// randomLBPolicy picks a pod uniformly at random. We have to do this for
+// unlimited concurrency, otherwise _all_ the requests would go to the
+// first pod, since it has unlimited capacity.
+func randomLBPolicy(_ context.Context, targets []*podTracker) (func(), *podTracker) {
+	return noop, targets[rand.Intn(len(targets))]
+}
+
+// firstAvailableLBPolicy picks the first tracker that has open capacity.
+func firstAvailableLBPolicy(ctx context.Context, targets []*podTracker) (func(), *podTracker) {
+	for _, t := range targets {
 		if cb, ok := t.Reserve(ctx); ok {
 			return cb, t
 		}
@@ -156,6 +208,66 @@ func pickPod(ctx context.Context, tgs []*podTracker, cc int) (func(), *podTracke
 	return noop, nil
 }
 
+// newRoundRobinPolicy returns a policy cycling through the trackers,
+// skipping the ones without open capacity.
+func newRoundRobinPolicy() lbPolicy {
+	var idx int32
+	return func(ctx context.Context, targets []*podTracker) (func(), *podTracker) {
+		l := len(targets)
+		start := int(atomic.AddInt32(&idx, 1)) - 1
+		for i := 0; i < l; i++ {
+			t := targets[(start+i)%l]
+			if cb, ok := t.Reserve(ctx); ok {
+				return cb, t
+			}
+		}
+		return noop, nil
+	}
+}
+
+// leastInFlightLBPolicy picks the tracker with the fewest requests in flight.
+func leastInFlightLBPolicy(ctx context.Context, targets []*podTracker) (func(), *podTracker) {
+	pick := targets[0]
+	for _, t := range targets[1:] {
+		if t.getWeight() < pick.getWeight() {
+			pick = t
+		}
+	}
+	return weightedReserve(ctx, targets, pick)
+}
+
+// randomChoice2LBPolicy picks two trackers at random and sends the request
+// to the one with fewer requests in flight.
+func randomChoice2LBPolicy(ctx context.Context, targets []*podTracker) (func(), *podTracker) {
+	l := len(targets)
+	if l == 1 {
+		return weightedReserve(ctx, targets, targets[0])
+	}
+	i, j := rand.Intn(l), rand.Intn(l)
+	for i == j {
+		j = rand.Intn(l)
+	}
+	pick := targets[i]
+	if targets[j].getWeight() < pick.getWeight() {
+		pick = targets[j]
+	}
+	return weightedReserve(ctx, targets, pick)
+}
+
+// weightedReserve reserves capacity on the picked tracker and maintains its
+// in flight request count. If the pick is at capacity, it falls back to the
+// first tracker with an open slot.
+func weightedReserve(ctx context.Context, targets []*podTracker, pick *podTracker) (func(), *podTracker) {
+	if cb, ok := pick.Reserve(ctx); ok {
+		pick.increaseWeight()
+		return func() {
+			pick.decreaseWeight()
+			cb()
+		}, pick
+	}
+	return firstAvailableLBPolicy(ctx, targets)
+}
+
 // Returns a dest that at the moment of choosing had an open slot
 // for request.
 func (rt *revisionThrottler) acquireDest(ctx context.Context) (func(), *podTracker) {
@@ -165,12 +277,18 @@ func (rt *revisionThrottler) acquireDest(ctx context.Context) (func(), *podTrack
 	if rt.clusterIPTracker != nil {
 		return noop, rt.clusterIPTracker
 	}
-	return pickPod(ctx, rt.assignedTrackers, rt.containerConcurrency)
+	return rt.lbPolicy(ctx, rt.assignedTrackers)
 }
 
 func (rt *revisionThrottler) try(ctx context.Context, function func(string) error) error {
 	var ret error
 
+	if rt.activationTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, rt.activationTimeout)
+		defer cancel()
+	}
+
 	if err := rt.breaker.Maybe(ctx, func() {
 		cb, tracker := rt.acquireDest(ctx)
 		if tracker == nil {
@@ -392,6 +510,7 @@ type Throttler struct {
 	revisionThrottlers      map[types.NamespacedName]*revisionThrottler
 	revisionThrottlersMutex sync.RWMutex
 	breakerParams           queue.BreakerParams
+	lbPolicy                network.LBPolicy
 	revisionLister          servinglisters.RevisionLister
 	numActivators           int32  // Total number of activators.
 	activatorIndex          int32  // The assigned index of this activator, -1 is Activator is not expected to receive traffic.
@@ -439,7 +558,7 @@ func NewThrottler(ctx context.Context,
 
 // Run starts the throttler and blocks until the context is done.
 func (t *Throttler) Run(ctx context.Context) {
-	rbm := newRevisionBackendsManager(ctx, network.AutoTransport)
+	rbm := newRevisionBackendsManager(ctx, pkgnet.AutoTransport)
 	// Update channel is closed when ctx is done.
 	t.run(rbm.updates())
 }
@@ -479,12 +598,33 @@ func (t *Throttler) getOrCreateRevisionThrottler(revID types.NamespacedName) (*r
 		if err != nil {
 			return nil, err
 		}
-		revThrottler = newRevisionThrottler(revID, int(rev.Spec.GetContainerConcurrency()), t.breakerParams, t.logger)
+		params, timeout := revisionBreakerParams(rev.Annotations, t.breakerParams)
+		revThrottler = newRevisionThrottler(revID, int(rev.Spec.GetContainerConcurrency()), t.lbPolicy, params, t.logger)
+		revThrottler.activationTimeout = timeout
 		t.revisionThrottlers[revID] = revThrottler
 	}
 	return revThrottler, nil
 }
 
+// revisionBreakerParams returns the breaker parameters for a revision,
+// applying the per-revision queue depth and buffering timeout annotations
+// over the given defaults. The values are validated in the webhook.
+func revisionBreakerParams(anns map[string]string, defaults queue.BreakerParams) (queue.BreakerParams, time.Duration) {
+	params := defaults
+	if v, ok := anns[autoscaling.ActivationQueueDepthAnnotationKey]; ok {
+		if qd, err := strconv.Atoi(v); err == nil && qd > 0 {
+			params.QueueDepth = qd
+		}
+	}
+	var timeout time.Duration
+	if v, ok := anns[autoscaling.ActivationTimeoutAnnotationKey]; ok {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			timeout = d
+		}
+	}
+	return params, timeout
+}
+
 // revisionUpdated is used to ensure we have a backlog set up for a revision as soon as it is created
 // rather than erroring with revision not found until a networking probe succeeds
 func (t *Throttler) revisionUpdated(obj interface{}) {
@@ -544,6 +684,24 @@ func inferIndex(eps []string, ipAddress string) int {
 	return idx
 }
 
+// UpdateLBPolicy swaps the load balancing policy on all existing revision
+// throttlers, as well as any created afterwards. It is called when
+// config-network changes.
+func (t *Throttler) UpdateLBPolicy(policy network.LBPolicy) {
+	t.revisionThrottlersMutex.Lock()
+	defer t.revisionThrottlersMutex.Unlock()
+	if t.lbPolicy == policy {
+		return
+	}
+	t.lbPolicy = policy
+	for _, rt := range t.revisionThrottlers {
+		newPolicy := pickLBPolicy(policy, rt.containerConcurrency)
+		rt.mux.Lock()
+		rt.lbPolicy = newPolicy
+		rt.mux.Unlock()
+	}
+}
+
 func (t *Throttler) updateAllThrottlerCapacity() {
 	t.revisionThrottlersMutex.RLock()
 	defer t.revisionThrottlersMutex.RUnlock()
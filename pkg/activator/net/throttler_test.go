@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"math"
+	"reflect"
+	"runtime"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -42,7 +44,9 @@ import (
 	"knative.dev/pkg/system"
 	_ "knative.dev/pkg/system/testing"
 	"knative.dev/serving/pkg/activator/util"
+	"knative.dev/serving/pkg/apis/autoscaling"
 	"knative.dev/serving/pkg/apis/networking"
+	"knative.dev/serving/pkg/network"
 	v1 "knative.dev/serving/pkg/apis/serving/v1"
 	fakeservingclient "knative.dev/serving/pkg/client/injection/client/fake"
 	revisioninformer "knative.dev/serving/pkg/client/injection/informers/serving/v1/revision"
@@ -438,7 +442,7 @@ func TestPodAssignmentFinite(t *testing.T) {
 	defer cancel()
 
 	throttler := newTestThrottler(ctx, 4 /*num activators*/)
-	rt := newRevisionThrottler(revName, 42 /*cc*/, defaultParams, logger)
+	rt := newRevisionThrottler(revName, 42 /*cc*/, network.LBPolicyDefault, defaultParams, logger)
 	throttler.revisionThrottlers[revName] = rt
 
 	update := revisionDestsUpdate{
@@ -490,7 +494,7 @@ func TestPodAssignmentInfinite(t *testing.T) {
 	defer cancel()
 
 	throttler := newTestThrottler(ctx, 2)
-	rt := newRevisionThrottler(revName, 0 /*cc*/, defaultParams, logger)
+	rt := newRevisionThrottler(revName, 0 /*cc*/, network.LBPolicyDefault, defaultParams, logger)
 	throttler.revisionThrottlers[revName] = rt
 
 	update := revisionDestsUpdate{
@@ -603,7 +607,7 @@ func TestMultipleActivators(t *testing.T) {
 func TestInfiniteBreakerCreation(t *testing.T) {
 	// This test verifies that we use infiniteBreaker when CC==0.
 	tttl := newRevisionThrottler(types.NamespacedName{Namespace: "a", Name: "b"}, 0, /*cc*/
-		queue.BreakerParams{}, TestLogger(t))
+		network.LBPolicyDefault, queue.BreakerParams{}, TestLogger(t))
 	if _, ok := tttl.breaker.(*infiniteBreaker); !ok {
 		t.Errorf("The type of revisionBreker = %T, want %T", tttl, (*infiniteBreaker)(nil))
 	}
@@ -933,3 +937,145 @@ func TestAssignSlice(t *testing.T) {
 		}
 	})
 }
+
+func TestRevisionBreakerParams(t *testing.T) {
+	tests := []struct {
+		name        string
+		anns        map[string]string
+		wantDepth   int
+		wantTimeout time.Duration
+	}{{
+		name:      "no annotations",
+		wantDepth: defaultParams.QueueDepth,
+	}, {
+		name: "queue depth override",
+		anns: map[string]string{
+			autoscaling.ActivationQueueDepthAnnotationKey: "42",
+		},
+		wantDepth: 42,
+	}, {
+		name: "timeout override",
+		anns: map[string]string{
+			autoscaling.ActivationTimeoutAnnotationKey: "11s",
+		},
+		wantDepth:   defaultParams.QueueDepth,
+		wantTimeout: 11 * time.Second,
+	}, {
+		name: "invalid values are ignored",
+		anns: map[string]string{
+			autoscaling.ActivationQueueDepthAnnotationKey: "-1",
+			autoscaling.ActivationTimeoutAnnotationKey:    "soon",
+		},
+		wantDepth: defaultParams.QueueDepth,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params, timeout := revisionBreakerParams(test.anns, defaultParams)
+			if params.QueueDepth != test.wantDepth {
+				t.Errorf("QueueDepth = %d, want: %d", params.QueueDepth, test.wantDepth)
+			}
+			if params.MaxConcurrency != defaultParams.MaxConcurrency {
+				t.Errorf("MaxConcurrency = %d, want: %d", params.MaxConcurrency, defaultParams.MaxConcurrency)
+			}
+			if timeout != test.wantTimeout {
+				t.Errorf("Timeout = %v, want: %v", timeout, test.wantTimeout)
+			}
+		})
+	}
+}
+
+func TestLBPolicies(t *testing.T) {
+	trackers := func() []*podTracker {
+		return []*podTracker{{
+			dest: "10.10.10.10:1234",
+		}, {
+			dest: "10.10.10.11:1234",
+		}, {
+			dest: "10.10.10.12:1234",
+		}}
+	}
+
+	t.Run("round-robin cycles through the pods", func(t *testing.T) {
+		policy := newRoundRobinPolicy()
+		tgs := trackers()
+		seen := sets.NewString()
+		for i := 0; i < len(tgs); i++ {
+			cb, tracker := policy(context.Background(), tgs)
+			seen.Insert(tracker.dest)
+			cb()
+		}
+		if got, want := seen.Len(), len(tgs); got != want {
+			t.Errorf("Round robin visited %d pods, want: %d", got, want)
+		}
+	})
+
+	t.Run("least-in-flight picks the idle pod", func(t *testing.T) {
+		tgs := trackers()
+		tgs[0].increaseWeight()
+		tgs[2].increaseWeight()
+		cb, tracker := leastInFlightLBPolicy(context.Background(), tgs)
+		if got, want := tracker.dest, tgs[1].dest; got != want {
+			t.Errorf("Picked %s, want: %s", got, want)
+		}
+		if got, want := tracker.getWeight(), int32(1); got != want {
+			t.Errorf("Weight = %d, want: %d", got, want)
+		}
+		cb()
+		if got, want := tracker.getWeight(), int32(0); got != want {
+			t.Errorf("Weight after callback = %d, want: %d", got, want)
+		}
+	})
+
+	t.Run("random-choice-2 prefers the less loaded pod", func(t *testing.T) {
+		tgs := trackers()[:2]
+		tgs[0].increaseWeight()
+		for i := 0; i < 10; i++ {
+			cb, tracker := randomChoice2LBPolicy(context.Background(), tgs)
+			if got, want := tracker.dest, tgs[1].dest; got != want {
+				t.Errorf("Picked %s, want: %s", got, want)
+			}
+			cb()
+		}
+	})
+
+	t.Run("random-choice-2 works with a single pod", func(t *testing.T) {
+		tgs := trackers()[:1]
+		cb, tracker := randomChoice2LBPolicy(context.Background(), tgs)
+		if got, want := tracker.dest, tgs[0].dest; got != want {
+			t.Errorf("Picked %s, want: %s", got, want)
+		}
+		cb()
+	})
+}
+
+func TestPickLBPolicy(t *testing.T) {
+	// The default depends on the container concurrency.
+	if got := pickLBPolicy(network.LBPolicyDefault, 0 /*cc*/); funcName(got) != funcName(lbPolicy(randomLBPolicy)) {
+		t.Errorf("Default policy for cc=0 = %s, want randomLBPolicy", funcName(got))
+	}
+	if got := pickLBPolicy(network.LBPolicyDefault, 10 /*cc*/); funcName(got) != funcName(lbPolicy(firstAvailableLBPolicy)) {
+		t.Errorf("Default policy for cc=10 = %s, want firstAvailableLBPolicy", funcName(got))
+	}
+	if got := pickLBPolicy(network.LBPolicyLeastInFlight, 10 /*cc*/); funcName(got) != funcName(lbPolicy(leastInFlightLBPolicy)) {
+		t.Errorf("Policy = %s, want leastInFlightLBPolicy", funcName(got))
+	}
+}
+
+func funcName(f lbPolicy) string {
+	return runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+}
+
+func TestThrottlerUpdateLBPolicy(t *testing.T) {
+	throttler := &Throttler{
+		revisionThrottlers: map[types.NamespacedName]*revisionThrottler{},
+		logger:             TestLogger(t),
+	}
+	rt := newRevisionThrottler(types.NamespacedName{Namespace: "a", Name: "b"}, 10, /*cc*/
+		network.LBPolicyDefault, defaultParams, TestLogger(t))
+	throttler.revisionThrottlers[rt.revID] = rt
+
+	throttler.UpdateLBPolicy(network.LBPolicyRandom)
+	if got := funcName(rt.lbPolicy); got != funcName(lbPolicy(randomLBPolicy)) {
+		t.Errorf("Policy after update = %s, want randomLBPolicy", got)
+	}
+}
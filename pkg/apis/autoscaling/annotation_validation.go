@@ -41,7 +41,7 @@ func ValidateAnnotations(anns map[string]string) *apis.FieldError {
 	if len(anns) == 0 {
 		return nil
 	}
-	return validateMinMaxScale(anns).Also(validateFloats(anns)).Also(validateWindows(anns).Also(validateMetric(anns)))
+	return validateMinMaxScale(anns).Also(validateFloats(anns)).Also(validateWindows(anns).Also(validateMetric(anns))).Also(validateInitialScale(anns)).Also(validateScalingSchedule(anns)).Also(validateActivation(anns)).Also(validateScaleDownDelay(anns))
 }
 
 func validateFloats(annotations map[string]string) *apis.FieldError {
@@ -69,6 +69,22 @@ func validateFloats(annotations map[string]string) *apis.FieldError {
 		}
 	}
 
+	if v, ok := annotations[MaxScaleUpRateAnnotationKey]; ok {
+		// Must be greater than 1.0, same as the cluster-wide
+		// max-scale-up-rate setting it overrides.
+		if fv, err := strconv.ParseFloat(v, 64); err != nil || fv <= 1.0 {
+			errs = errs.Also(apis.ErrInvalidValue(v, MaxScaleUpRateAnnotationKey))
+		}
+	}
+
+	if v, ok := annotations[MaxScaleDownRateAnnotationKey]; ok {
+		// Must be greater than 1.0, same as the cluster-wide
+		// max-scale-down-rate setting it overrides.
+		if fv, err := strconv.ParseFloat(v, 64); err != nil || fv <= 1.0 {
+			errs = errs.Also(apis.ErrInvalidValue(v, MaxScaleDownRateAnnotationKey))
+		}
+	}
+
 	if v, ok := annotations[TargetUtilizationPercentageKey]; ok {
 		if fv, err := strconv.ParseFloat(v, 64); err != nil {
 			errs = errs.Also(apis.ErrInvalidValue(v, TargetUtilizationPercentageKey))
@@ -88,8 +104,11 @@ func validateFloats(annotations map[string]string) *apis.FieldError {
 func validateWindows(annotations map[string]string) *apis.FieldError {
 	var errs *apis.FieldError
 	if w, ok := annotations[WindowAnnotationKey]; ok {
-		if annotations[ClassAnnotationKey] == HPA && annotations[MetricAnnotationKey] == CPU {
-			return apis.ErrInvalidKeyName(WindowAnnotationKey, fmt.Sprintf("%s for %s %s", HPA, MetricAnnotationKey, CPU))
+		if annotations[ClassAnnotationKey] == HPA {
+			switch annotations[MetricAnnotationKey] {
+			case CPU, Memory:
+				return apis.ErrInvalidKeyName(WindowAnnotationKey, fmt.Sprintf("%s for %s %s", HPA, MetricAnnotationKey, annotations[MetricAnnotationKey]))
+			}
 		}
 		d, err := time.ParseDuration(w)
 		if err != nil {
@@ -101,6 +120,25 @@ func validateWindows(annotations map[string]string) *apis.FieldError {
 	return errs
 }
 
+func validateScaleDownDelay(annotations map[string]string) *apis.FieldError {
+	var errs *apis.FieldError
+	if w, ok := annotations[ScaleDownDelayAnnotationKey]; ok {
+		if d, err := time.ParseDuration(w); err != nil {
+			errs = apis.ErrInvalidValue(w, ScaleDownDelayAnnotationKey)
+		} else if d < 0 || d > WindowMax {
+			// Since we disallow windows longer than WindowMax, so we should
+			// not permit delays longer than that either.
+			errs = apis.ErrOutOfBoundsValue(w, 0*time.Second, WindowMax, ScaleDownDelayAnnotationKey)
+		} else if d.Round(time.Second) != d {
+			errs = &apis.FieldError{
+				Message: fmt.Sprintf("must be specified with at most second precision, got %v", d),
+				Paths:   []string{ScaleDownDelayAnnotationKey},
+			}
+		}
+	}
+	return errs
+}
+
 func validateMinMaxScale(annotations map[string]string) *apis.FieldError {
 	var errs *apis.FieldError
 
@@ -119,6 +157,46 @@ func validateMinMaxScale(annotations map[string]string) *apis.FieldError {
 	return errs
 }
 
+func validateInitialScale(annotations map[string]string) *apis.FieldError {
+	if v, ok := annotations[InitialScaleAnnotationKey]; ok {
+		// Whether zero is permitted is dictated by the `allow-zero-initial-scale`
+		// key of config-autoscaler, so here we only check for a non-negative integer.
+		if i, err := strconv.ParseInt(v, 10, 32); err != nil || i < 0 {
+			return apis.ErrInvalidValue(v, InitialScaleAnnotationKey)
+		}
+	}
+	return nil
+}
+
+func validateActivation(annotations map[string]string) *apis.FieldError {
+	var errs *apis.FieldError
+	if v, ok := annotations[ActivationQueueDepthAnnotationKey]; ok {
+		if i, err := strconv.ParseInt(v, 10, 32); err != nil || i < 1 {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(v, 1, math.MaxInt32, ActivationQueueDepthAnnotationKey))
+		}
+	}
+	if v, ok := annotations[ActivationTimeoutAnnotationKey]; ok {
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			errs = errs.Also(apis.ErrInvalidValue(v, ActivationTimeoutAnnotationKey))
+		}
+	}
+	if v, ok := annotations[ActivationScaleKey]; ok {
+		if i, err := strconv.ParseInt(v, 10, 32); err != nil || i < 1 {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(v, 1, math.MaxInt32, ActivationScaleKey))
+		}
+	}
+	return errs
+}
+
+func validateScalingSchedule(annotations map[string]string) *apis.FieldError {
+	if v, ok := annotations[ScalingScheduleAnnotationKey]; ok {
+		if _, err := ParseScalingSchedule(v); err != nil {
+			return apis.ErrInvalidValue(v, ScalingScheduleAnnotationKey)
+		}
+	}
+	return nil
+}
+
 func validateMetric(annotations map[string]string) *apis.FieldError {
 	if metric, ok := annotations[MetricAnnotationKey]; ok {
 		classValue := KPA
@@ -132,8 +210,10 @@ func validateMetric(annotations map[string]string) *apis.FieldError {
 				return nil
 			}
 		case HPA:
-			switch metric {
-			case CPU, Concurrency, RPS:
+			// Besides the built-in metrics, the HPA class passes any other
+			// non-empty metric name through to the custom metrics API, so
+			// that metric adapters (e.g. prometheus-adapter) can serve it.
+			if metric != "" {
 				return nil
 			}
 		default:
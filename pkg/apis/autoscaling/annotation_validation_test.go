@@ -80,6 +80,20 @@ func TestValidateScaleBoundAnnotations(t *testing.T) {
 			MinScaleAnnotationKey: "0",
 			MaxScaleAnnotationKey: "0",
 		},
+	}, {
+		name:        "initialScale is 0",
+		annotations: map[string]string{InitialScaleAnnotationKey: "0"},
+	}, {
+		name:        "initialScale is 2",
+		annotations: map[string]string{InitialScaleAnnotationKey: "2"},
+	}, {
+		name:        "initialScale is -1",
+		annotations: map[string]string{InitialScaleAnnotationKey: "-1"},
+		expectErr:   "invalid value: -1: " + InitialScaleAnnotationKey,
+	}, {
+		name:        "initialScale is foo",
+		annotations: map[string]string{InitialScaleAnnotationKey: "foo"},
+		expectErr:   "invalid value: foo: " + InitialScaleAnnotationKey,
 	}, {
 		name:        "panic window percentange bad",
 		annotations: map[string]string{PanicWindowPercentageAnnotationKey: "-1"},
@@ -143,6 +157,60 @@ func TestValidateScaleBoundAnnotations(t *testing.T) {
 		name:        "TU too big",
 		annotations: map[string]string{TargetUtilizationPercentageKey: "101"},
 		expectErr:   "expected 1 <= 101 <= 100: " + TargetUtilizationPercentageKey,
+	}, {
+		name:        "max scale up rate valid",
+		annotations: map[string]string{MaxScaleUpRateAnnotationKey: "100"},
+	}, {
+		name:        "max scale up rate invalid",
+		annotations: map[string]string{MaxScaleUpRateAnnotationKey: "gangplank"},
+		expectErr:   "invalid value: gangplank: " + MaxScaleUpRateAnnotationKey,
+	}, {
+		name:        "max scale up rate too small",
+		annotations: map[string]string{MaxScaleUpRateAnnotationKey: "0.9"},
+		expectErr:   "invalid value: 0.9: " + MaxScaleUpRateAnnotationKey,
+	}, {
+		name:        "max scale down rate valid",
+		annotations: map[string]string{MaxScaleDownRateAnnotationKey: "2.5"},
+	}, {
+		name:        "max scale down rate invalid",
+		annotations: map[string]string{MaxScaleDownRateAnnotationKey: "gangplank"},
+		expectErr:   "invalid value: gangplank: " + MaxScaleDownRateAnnotationKey,
+	}, {
+		name:        "max scale down rate too small",
+		annotations: map[string]string{MaxScaleDownRateAnnotationKey: "1.0"},
+		expectErr:   "invalid value: 1.0: " + MaxScaleDownRateAnnotationKey,
+	}, {
+		name:        "activation queue depth valid",
+		annotations: map[string]string{ActivationQueueDepthAnnotationKey: "42"},
+	}, {
+		name:        "activation queue depth invalid",
+		annotations: map[string]string{ActivationQueueDepthAnnotationKey: "0"},
+		expectErr:   "expected 1 <= 0 <= 2147483647: " + ActivationQueueDepthAnnotationKey,
+	}, {
+		name:        "activation timeout valid",
+		annotations: map[string]string{ActivationTimeoutAnnotationKey: "30s"},
+	}, {
+		name:        "activation timeout invalid",
+		annotations: map[string]string{ActivationTimeoutAnnotationKey: "eventually"},
+		expectErr:   "invalid value: eventually: " + ActivationTimeoutAnnotationKey,
+	}, {
+		name:        "activation scale valid",
+		annotations: map[string]string{ActivationScaleKey: "3"},
+	}, {
+		name:        "activation scale zero",
+		annotations: map[string]string{ActivationScaleKey: "0"},
+		expectErr:   "expected 1 <= 0 <= 2147483647: " + ActivationScaleKey,
+	}, {
+		name:        "activation scale malformed",
+		annotations: map[string]string{ActivationScaleKey: "lots"},
+		expectErr:   "expected 1 <= lots <= 2147483647: " + ActivationScaleKey,
+	}, {
+		name:        "scaling schedule valid",
+		annotations: map[string]string{ScalingScheduleAnnotationKey: "07:00-19:00=5,22:00-06:00=2"},
+	}, {
+		name:        "scaling schedule invalid",
+		annotations: map[string]string{ScalingScheduleAnnotationKey: "whenever=5"},
+		expectErr:   "invalid value: whenever=5: " + ScalingScheduleAnnotationKey,
 	}, {
 		name:        "TU invalid",
 		annotations: map[string]string{TargetUtilizationPercentageKey: "dghyak"},
@@ -159,10 +227,36 @@ func TestValidateScaleBoundAnnotations(t *testing.T) {
 		name:        "window too long",
 		annotations: map[string]string{WindowAnnotationKey: "365h"},
 		expectErr:   "expected 6s <= 365h <= 1h0m0s: " + WindowAnnotationKey,
+	}, {
+		name:        "scale down delay good",
+		annotations: map[string]string{ScaleDownDelayAnnotationKey: "15m"},
+	}, {
+		name:        "scale down delay zero",
+		annotations: map[string]string{ScaleDownDelayAnnotationKey: "0s"},
+	}, {
+		name:        "scale down delay invalid",
+		annotations: map[string]string{ScaleDownDelayAnnotationKey: "sandwich"},
+		expectErr:   "invalid value: sandwich: " + ScaleDownDelayAnnotationKey,
+	}, {
+		name:        "scale down delay too long",
+		annotations: map[string]string{ScaleDownDelayAnnotationKey: "365h"},
+		expectErr:   "expected 0s <= 365h <= 1h0m0s: " + ScaleDownDelayAnnotationKey,
+	}, {
+		name:        "scale down delay negative",
+		annotations: map[string]string{ScaleDownDelayAnnotationKey: "-1s"},
+		expectErr:   "expected 0s <= -1s <= 1h0m0s: " + ScaleDownDelayAnnotationKey,
+	}, {
+		name:        "scale down delay sub-second precision",
+		annotations: map[string]string{ScaleDownDelayAnnotationKey: "1500ms"},
+		expectErr:   "must be specified with at most second precision, got 1.5s: " + ScaleDownDelayAnnotationKey,
 	}, {
 		name:        "annotation /window is invalid for class HPA and metric CPU",
 		annotations: map[string]string{WindowAnnotationKey: "7s", ClassAnnotationKey: HPA, MetricAnnotationKey: CPU},
 		expectErr:   fmt.Sprintf(`invalid key name %q: %s for %s %s`, WindowAnnotationKey, HPA, MetricAnnotationKey, CPU),
+	}, {
+		name:        "annotation /window is invalid for class HPA and metric Memory",
+		annotations: map[string]string{WindowAnnotationKey: "7s", ClassAnnotationKey: HPA, MetricAnnotationKey: Memory},
+		expectErr:   fmt.Sprintf(`invalid key name %q: %s for %s %s`, WindowAnnotationKey, HPA, MetricAnnotationKey, Memory),
 	}, {
 		name:        "annotation /window is valid for class KPA",
 		annotations: map[string]string{WindowAnnotationKey: "7s", ClassAnnotationKey: KPA},
@@ -214,9 +308,12 @@ func TestValidateScaleBoundAnnotations(t *testing.T) {
 		annotations: map[string]string{MetricAnnotationKey: CPU},
 		expectErr:   "invalid value: cpu: " + MetricAnnotationKey,
 	}, {
-		name:        "invalid metric for HPA class",
-		annotations: map[string]string{MetricAnnotationKey: "metrics", ClassAnnotationKey: HPA},
-		expectErr:   "invalid value: metrics: " + MetricAnnotationKey,
+		name:        "empty metric for HPA class",
+		annotations: map[string]string{MetricAnnotationKey: "", ClassAnnotationKey: HPA},
+		expectErr:   "invalid value: : " + MetricAnnotationKey,
+	}, {
+		name:        "valid class HPA with custom metric",
+		annotations: map[string]string{MetricAnnotationKey: "queue-depth", ClassAnnotationKey: HPA},
 	}, {
 		name:        "valid class KPA with metric RPS",
 		annotations: map[string]string{MetricAnnotationKey: RPS},
@@ -229,6 +326,9 @@ func TestValidateScaleBoundAnnotations(t *testing.T) {
 	}, {
 		name:        "valid class HPA with metric CPU",
 		annotations: map[string]string{ClassAnnotationKey: HPA, MetricAnnotationKey: CPU},
+	}, {
+		name:        "valid class HPA with metric Memory",
+		annotations: map[string]string{ClassAnnotationKey: HPA, MetricAnnotationKey: Memory},
 	}, {
 		name:        "valid class HPA with metric RPS",
 		annotations: map[string]string{ClassAnnotationKey: HPA, MetricAnnotationKey: RPS},
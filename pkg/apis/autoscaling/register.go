@@ -44,6 +44,38 @@ const (
 	//   autoscaling.knative.dev/maxScale: "10"
 	MaxScaleAnnotationKey = GroupName + "/maxScale"
 
+	// ScalingScheduleAnnotationKey is the annotation to specify daily windows
+	// during which the PodAutoscaler should maintain a higher minimum scale,
+	// so predictable peaks don't have to wait for reactive scaling.
+	// The value is a comma separated list of UTC time windows, e.g.
+	//   autoscaling.knative.dev/scalingSchedule: "07:00-19:00=5"
+	// Only the kpa.autoscaling.knative.dev class autoscaler supports
+	// the scalingSchedule annotation.
+	ScalingScheduleAnnotationKey = GroupName + "/scalingSchedule"
+
+	// InitialScaleAnnotationKey is the annotation to specify the initial scale of
+	// a revision when a service is initially deployed. This number can be set to 0 iff
+	// allow-zero-initial-scale of config-autoscaler is true.
+	InitialScaleAnnotationKey = GroupName + "/initialScale"
+
+	// MaxScaleUpRateAnnotationKey is the annotation to specify the maximum
+	// ratio of desired pods versus observed pods for a single autoscaler
+	// evaluation cycle. For example,
+	//   autoscaling.knative.dev/maxScaleUpRate: "2.0"
+	// means the number of pods can at most double per cycle.
+	// Must be greater than 1.0. Overrides the max-scale-up-rate entry of
+	// config-autoscaler.
+	MaxScaleUpRateAnnotationKey = GroupName + "/maxScaleUpRate"
+
+	// MaxScaleDownRateAnnotationKey is the annotation to specify the maximum
+	// ratio of observed pods versus desired pods for a single autoscaler
+	// evaluation cycle. For example,
+	//   autoscaling.knative.dev/maxScaleDownRate: "2.0"
+	// means the number of pods can at most be halved per cycle.
+	// Must be greater than 1.0. Overrides the max-scale-down-rate entry of
+	// config-autoscaler.
+	MaxScaleDownRateAnnotationKey = GroupName + "/maxScaleDownRate"
+
 	// MetricAnnotationKey is the annotation to specify what metric the PodAutoscaler
 	// should be scaled on. For example,
 	//   autoscaling.knative.dev/metric: cpu
@@ -52,6 +84,8 @@ const (
 	Concurrency = "concurrency"
 	// CPU is the amount of the requested cpu actually being consumed by the Pod.
 	CPU = "cpu"
+	// Memory is the amount of memory consumed by the Pod.
+	Memory = "memory"
 	// RPS is the requests per second reaching the Pod.
 	RPS = "rps"
 
@@ -59,6 +93,9 @@ const (
 	// PodAutoscaler should attempt to maintain. For example,
 	//   autoscaling.knative.dev/metric: cpu
 	//   autoscaling.knative.dev/target: "75"   # target 75% cpu utilization
+	// For the memory metric the target is in mebibytes, e.g.
+	//   autoscaling.knative.dev/metric: memory
+	//   autoscaling.knative.dev/target: "100"  # target 100MiB memory usage
 	TargetAnnotationKey = GroupName + "/target"
 	// TargetMin is the minimum allowable target.
 	// This can be less than 1 due to the fact that with small container
@@ -86,12 +123,46 @@ const (
 	// This keeps the event horizon to a resonable enough limit.
 	WindowMax = 1 * time.Hour
 
+	// ScaleDownDelayAnnotationKey is the annotation to specify a time
+	// that must pass at reduced concurrency before a scale-down decision
+	// is applied. This keeps pods around for longer than strictly
+	// necessary, trading resource cost for fewer cold starts when
+	// sawtooth traffic would otherwise make the scale flap. For example,
+	//   autoscaling.knative.dev/scaleDownDelay: "15m"
+	// Only the kpa.autoscaling.knative.dev class autoscaler supports
+	// the scaleDownDelay annotation. Overrides the scale-down-delay
+	// entry of config-autoscaler.
+	ScaleDownDelayAnnotationKey = GroupName + "/scaleDownDelay"
+
 	// TargetUtilizationPercentageKey is the annotation which specifies the
 	// desired target resource utilization for the revision.
 	// TargetUtilization is a percentage in the 1 <= TU <= 100 range.
 	// This annotation takes precedence over the config map value.
 	TargetUtilizationPercentageKey = GroupName + "/targetUtilizationPercentage"
 
+	// ActivationQueueDepthAnnotationKey is the annotation to specify the
+	// depth of the queue in which the activator buffers requests for the
+	// revision, per activator pod, while the revision is scaled to zero or
+	// otherwise lacks capacity. When the queue is full requests are
+	// rejected with a 503 and a Retry-After header. For example,
+	//   autoscaling.knative.dev/activationQueueDepth: "100"
+	ActivationQueueDepthAnnotationKey = GroupName + "/activationQueueDepth"
+
+	// ActivationScaleKey is the annotation to specify the minimum number
+	// of pods to scale to when scaling from zero. For example,
+	//   autoscaling.knative.dev/activationScale: "3"
+	// means the revision jumps straight to 3 pods on activation instead
+	// of 1, which helps when a single pod can never absorb the first
+	// burst to an idle-but-popular endpoint. Once regular autoscaling
+	// takes over, the scale may settle below this value again.
+	ActivationScaleKey = GroupName + "/activationScale"
+
+	// ActivationTimeoutAnnotationKey is the annotation to specify how long
+	// the activator may buffer a request for the revision before giving up
+	// and responding with a 503. For example,
+	//   autoscaling.knative.dev/activationTimeout: "30s"
+	ActivationTimeoutAnnotationKey = GroupName + "/activationTimeout"
+
 	// TargetBurstCapacityKey specifies the desired burst capacity for the
 	// revision. Possible values are:
 	// -1 -- infinite;
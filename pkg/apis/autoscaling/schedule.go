@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ScheduleWindow is a daily window, during which the revision should be
+// scaled to at least MinScale pods. Start and End are offsets from
+// midnight UTC. A window with Start > End wraps around midnight.
+type ScheduleWindow struct {
+	Start    time.Duration
+	End      time.Duration
+	MinScale int32
+}
+
+// timeOfDay parses a "HH:MM" string into an offset from midnight.
+func timeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// ParseScalingSchedule parses the value of the scalingSchedule annotation.
+// The format is a comma separated list of windows, e.g.
+//   07:00-19:00=5,19:00-23:30=2
+// where the times are UTC and the number is the minimum scale to maintain
+// while the window is active.
+func ParseScalingSchedule(s string) ([]ScheduleWindow, error) {
+	var windows []ScheduleWindow
+	for _, p := range strings.Split(s, ",") {
+		parts := strings.SplitN(p, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("expected form HH:MM-HH:MM=minScale, got %q", p)
+		}
+		times := strings.SplitN(parts[0], "-", 2)
+		if len(times) != 2 {
+			return nil, fmt.Errorf("expected time range HH:MM-HH:MM, got %q", parts[0])
+		}
+		start, err := timeOfDay(times[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid start time %q: %w", times[0], err)
+		}
+		end, err := timeOfDay(times[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid end time %q: %w", times[1], err)
+		}
+		if start == end {
+			return nil, fmt.Errorf("window %q is empty", parts[0])
+		}
+		ms, err := strconv.ParseInt(parts[1], 10, 32)
+		if err != nil || ms < 1 {
+			return nil, fmt.Errorf("invalid minScale %q, must be a positive integer", parts[1])
+		}
+		windows = append(windows, ScheduleWindow{
+			Start:    start,
+			End:      end,
+			MinScale: int32(ms),
+		})
+	}
+	return windows, nil
+}
+
+// active returns whether the window covers the given offset from midnight.
+func (w *ScheduleWindow) active(t time.Duration) bool {
+	if w.Start < w.End {
+		return t >= w.Start && t < w.End
+	}
+	// The window wraps around midnight.
+	return t >= w.Start || t < w.End
+}
+
+// ScheduledMinScale returns the largest minimum scale among the windows
+// active at the given time and the duration until the next window boundary,
+// at which point the result may change.
+func ScheduledMinScale(windows []ScheduleWindow, now time.Time) (minScale int32, next time.Duration) {
+	now = now.UTC()
+	t := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute +
+		time.Duration(now.Second())*time.Second + time.Duration(now.Nanosecond())
+	const day = 24 * time.Hour
+	next = day
+	for _, w := range windows {
+		if w.active(t) && w.MinScale > minScale {
+			minScale = w.MinScale
+		}
+		for _, b := range []time.Duration{w.Start, w.End} {
+			d := (b - t + day) % day
+			if d == 0 {
+				d = day
+			}
+			if d < next {
+				next = d
+			}
+		}
+	}
+	return minScale, next
+}
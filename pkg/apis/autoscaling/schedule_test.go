@@ -0,0 +1,167 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseScalingSchedule(t *testing.T) {
+	cases := []struct {
+		name      string
+		value     string
+		want      []ScheduleWindow
+		expectErr bool
+	}{{
+		name:  "single window",
+		value: "07:00-19:00=5",
+		want: []ScheduleWindow{{
+			Start:    7 * time.Hour,
+			End:      19 * time.Hour,
+			MinScale: 5,
+		}},
+	}, {
+		name:  "multiple windows",
+		value: "07:00-19:00=5,19:00-23:30=2",
+		want: []ScheduleWindow{{
+			Start:    7 * time.Hour,
+			End:      19 * time.Hour,
+			MinScale: 5,
+		}, {
+			Start:    19 * time.Hour,
+			End:      23*time.Hour + 30*time.Minute,
+			MinScale: 2,
+		}},
+	}, {
+		name:  "wraps around midnight",
+		value: "22:00-06:00=3",
+		want: []ScheduleWindow{{
+			Start:    22 * time.Hour,
+			End:      6 * time.Hour,
+			MinScale: 3,
+		}},
+	}, {
+		name:      "missing min scale",
+		value:     "07:00-19:00",
+		expectErr: true,
+	}, {
+		name:      "missing end time",
+		value:     "07:00=5",
+		expectErr: true,
+	}, {
+		name:      "bad time",
+		value:     "7am-19:00=5",
+		expectErr: true,
+	}, {
+		name:      "empty window",
+		value:     "07:00-07:00=5",
+		expectErr: true,
+	}, {
+		name:      "zero min scale",
+		value:     "07:00-19:00=0",
+		expectErr: true,
+	}, {
+		name:      "negative min scale",
+		value:     "07:00-19:00=-2",
+		expectErr: true,
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseScalingSchedule(tc.value)
+			if tc.expectErr != (err != nil) {
+				t.Errorf("ParseScalingSchedule() error = %v, expected error = %v", err, tc.expectErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ParseScalingSchedule() = (-want, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestScheduledMinScale(t *testing.T) {
+	windows := []ScheduleWindow{{
+		Start:    7 * time.Hour,
+		End:      19 * time.Hour,
+		MinScale: 5,
+	}, {
+		Start:    18 * time.Hour,
+		End:      23 * time.Hour,
+		MinScale: 2,
+	}}
+	cases := []struct {
+		name     string
+		now      time.Time
+		want     int32
+		wantNext time.Duration
+	}{{
+		name:     "before all windows",
+		now:      time.Date(2020, 2, 4, 6, 0, 0, 0, time.UTC),
+		want:     0,
+		wantNext: time.Hour,
+	}, {
+		name:     "inside first window",
+		now:      time.Date(2020, 2, 4, 12, 0, 0, 0, time.UTC),
+		want:     5,
+		wantNext: 6 * time.Hour,
+	}, {
+		name:     "overlapping windows take the max",
+		now:      time.Date(2020, 2, 4, 18, 30, 0, 0, time.UTC),
+		want:     5,
+		wantNext: 30 * time.Minute,
+	}, {
+		name:     "inside second window only",
+		now:      time.Date(2020, 2, 4, 20, 0, 0, 0, time.UTC),
+		want:     2,
+		wantNext: 3 * time.Hour,
+	}, {
+		name:     "after all windows wraps to next day",
+		now:      time.Date(2020, 2, 4, 23, 30, 0, 0, time.UTC),
+		want:     0,
+		wantNext: 7*time.Hour + 30*time.Minute,
+	}}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, next := ScheduledMinScale(windows, tc.now)
+			if got != tc.want {
+				t.Errorf("ScheduledMinScale() = %d, want %d", got, tc.want)
+			}
+			if next != tc.wantNext {
+				t.Errorf("ScheduledMinScale() next = %v, want %v", next, tc.wantNext)
+			}
+		})
+	}
+}
+
+func TestScheduledMinScaleMidnightWrap(t *testing.T) {
+	windows := []ScheduleWindow{{
+		Start:    22 * time.Hour,
+		End:      6 * time.Hour,
+		MinScale: 3,
+	}}
+	if got, _ := ScheduledMinScale(windows, time.Date(2020, 2, 4, 23, 0, 0, 0, time.UTC)); got != 3 {
+		t.Errorf("ScheduledMinScale() = %d, want 3", got)
+	}
+	if got, _ := ScheduledMinScale(windows, time.Date(2020, 2, 4, 5, 0, 0, 0, time.UTC)); got != 3 {
+		t.Errorf("ScheduledMinScale() = %d, want 3", got)
+	}
+	if got, _ := ScheduledMinScale(windows, time.Date(2020, 2, 4, 12, 0, 0, 0, time.UTC)); got != 0 {
+		t.Errorf("ScheduledMinScale() = %d, want 0", got)
+	}
+}
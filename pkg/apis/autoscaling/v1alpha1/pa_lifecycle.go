@@ -30,6 +30,7 @@ import (
 
 var podCondSet = apis.NewLivingConditionSet(
 	PodAutoscalerConditionActive,
+	PodAutoscalerConditionScaleTargetInitialized,
 )
 
 func (pa *PodAutoscaler) GetGroupVersionKind() schema.GroupVersionKind {
@@ -86,6 +87,24 @@ func (pa *PodAutoscaler) ScaleBounds() (min, max int32) {
 	return
 }
 
+// ActivationScale returns the minimum scale when scaling from zero, as
+// set by the activationScale annotation, or 0 if not present.
+func (pa *PodAutoscaler) ActivationScale() int32 {
+	// The value is validated in the webhook.
+	return pa.annotationInt32(autoscaling.ActivationScaleKey)
+}
+
+// ScalingSchedule returns the scheduled scaling windows from the
+// scalingSchedule annotation, or nil if not present, or invalid.
+func (pa *PodAutoscaler) ScalingSchedule() []autoscaling.ScheduleWindow {
+	if s, ok := pa.Annotations[autoscaling.ScalingScheduleAnnotationKey]; ok {
+		// The value is validated in the webhook.
+		ws, _ := autoscaling.ParseScalingSchedule(s)
+		return ws
+	}
+	return nil
+}
+
 // Target returns the target annotation value or false if not present, or invalid.
 func (pa *PodAutoscaler) Target() (float64, bool) {
 	return pa.annotationFloat64(autoscaling.TargetAnnotationKey)
@@ -117,6 +136,31 @@ func (pa *PodAutoscaler) Window() (window time.Duration, ok bool) {
 	return 0, false
 }
 
+// ScaleDownDelay returns the scale down delay annotation value, or false
+// if not present.
+func (pa *PodAutoscaler) ScaleDownDelay() (delay time.Duration, ok bool) {
+	// The value is validated in the webhook.
+	if s, ok := pa.Annotations[autoscaling.ScaleDownDelayAnnotationKey]; ok {
+		d, err := time.ParseDuration(s)
+		return d, err == nil
+	}
+	return 0, false
+}
+
+// MaxScaleUpRate returns the maximum scale up rate annotation value or
+// false if not present.
+func (pa *PodAutoscaler) MaxScaleUpRate() (rate float64, ok bool) {
+	// The value is validated in the webhook.
+	return pa.annotationFloat64(autoscaling.MaxScaleUpRateAnnotationKey)
+}
+
+// MaxScaleDownRate returns the maximum scale down rate annotation value or
+// false if not present.
+func (pa *PodAutoscaler) MaxScaleDownRate() (rate float64, ok bool) {
+	// The value is validated in the webhook.
+	return pa.annotationFloat64(autoscaling.MaxScaleDownRateAnnotationKey)
+}
+
 // PanicWindowPercentage returns panic window annotation value or false if not present.
 func (pa *PodAutoscaler) PanicWindowPercentage() (percentage float64, ok bool) {
 	// The value is validated in the webhook.
@@ -158,6 +202,19 @@ func (pas *PodAutoscalerStatus) InitializeConditions() {
 	podCondSet.Manage(pas).InitializeConditions()
 }
 
+// IsScaleTargetInitialized returns whether the scale target of the PA
+// has already been scaled to the initial scale at least once.
+func (pas *PodAutoscalerStatus) IsScaleTargetInitialized() bool {
+	cond := pas.GetCondition(PodAutoscalerConditionScaleTargetInitialized)
+	return cond != nil && cond.Status == corev1.ConditionTrue
+}
+
+// MarkScaleTargetInitialized marks the PA's ScaleTargetInitialized condition true.
+// Once true this condition is never unset.
+func (pas *PodAutoscalerStatus) MarkScaleTargetInitialized() {
+	podCondSet.Manage(pas).MarkTrue(PodAutoscalerConditionScaleTargetInitialized)
+}
+
 // MarkActive marks the PA active.
 func (pas *PodAutoscalerStatus) MarkActive() {
 	podCondSet.Manage(pas).MarkTrue(PodAutoscalerConditionActive)
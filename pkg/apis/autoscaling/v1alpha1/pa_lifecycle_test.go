@@ -737,6 +737,78 @@ func TestWindowAnnotation(t *testing.T) {
 	}
 }
 
+func TestActivationScale(t *testing.T) {
+	cases := []struct {
+		name string
+		pa   *PodAutoscaler
+		want int32
+	}{{
+		name: "not present",
+		pa:   pa(map[string]string{}),
+		want: 0,
+	}, {
+		name: "present",
+		pa: pa(map[string]string{
+			autoscaling.ActivationScaleKey: "3",
+		}),
+		want: 3,
+	}, {
+		name: "invalid",
+		pa: pa(map[string]string{
+			autoscaling.ActivationScaleKey: "lots",
+		}),
+		want: 0,
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.pa.ActivationScale(); got != tc.want {
+				t.Errorf("ActivationScale() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestScaleDownDelayAnnotation(t *testing.T) {
+	cases := []struct {
+		name      string
+		pa        *PodAutoscaler
+		wantDelay time.Duration
+		wantOK    bool
+	}{{
+		name:      "not present",
+		pa:        pa(map[string]string{}),
+		wantDelay: 0,
+		wantOK:    false,
+	}, {
+		name: "present",
+		pa: pa(map[string]string{
+			autoscaling.ScaleDownDelayAnnotationKey: "15m",
+		}),
+		wantDelay: 15 * time.Minute,
+		wantOK:    true,
+	}, {
+		name: "invalid",
+		pa: pa(map[string]string{
+			autoscaling.ScaleDownDelayAnnotationKey: "fortnight",
+		}),
+		wantDelay: 0,
+		wantOK:    false,
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotDelay, gotOK := tc.pa.ScaleDownDelay()
+			if gotDelay != tc.wantDelay {
+				t.Errorf("%q expected delay: %v got: %v", tc.name, tc.wantDelay, gotDelay)
+			}
+			if gotOK != tc.wantOK {
+				t.Errorf("%q expected ok: %v got %v", tc.name, tc.wantOK, gotOK)
+			}
+		})
+	}
+}
+
 func TestPanicWindowPercentageAnnotation(t *testing.T) {
 	cases := []struct {
 		name           string
@@ -869,12 +941,21 @@ func TestTypicalFlow(t *testing.T) {
 	r := &PodAutoscalerStatus{}
 	r.InitializeConditions()
 	apistest.CheckConditionOngoing(r, PodAutoscalerConditionActive, t)
+	apistest.CheckConditionOngoing(r, PodAutoscalerConditionScaleTargetInitialized, t)
 	apistest.CheckConditionOngoing(r, PodAutoscalerConditionReady, t)
+	if r.IsScaleTargetInitialized() {
+		t.Error("IsScaleTargetInitialized was set prematurely.")
+	}
 
-	// When we see traffic, mark ourselves active.
+	// When we see traffic and the initial scale was achieved, mark ourselves active.
+	r.MarkScaleTargetInitialized()
 	r.MarkActive()
 	apistest.CheckConditionSucceeded(r, PodAutoscalerConditionActive, t)
+	apistest.CheckConditionSucceeded(r, PodAutoscalerConditionScaleTargetInitialized, t)
 	apistest.CheckConditionSucceeded(r, PodAutoscalerConditionReady, t)
+	if !r.IsScaleTargetInitialized() {
+		t.Error("IsScaleTargetInitialized was not set.")
+	}
 
 	// Check idempotency.
 	r.MarkActive()
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// FeaturesConfigName is the name of the config map for the features.
+	FeaturesConfigName = "config-features"
+)
+
+// Flag is the value of a feature flag.
+type Flag string
+
+const (
+	// Enabled turns on an optional behavior.
+	Enabled Flag = "Enabled"
+	// Disabled turns off an optional behavior.
+	Disabled Flag = "Disabled"
+	// Allowed neither explicitly disables or enables a behavior.
+	// eg. allow a client to control behavior with an annotation or allow a new value through validation.
+	Allowed Flag = "Allowed"
+)
+
+// Features specifies which experimental features are allowed by the webhook.
+type Features struct {
+	PodSpecAffinity                  Flag
+	PodSpecNodeSelector              Flag
+	PodSpecTolerations               Flag
+	PodSpecTopologySpreadConstraints Flag
+	PodSpecHostAliases               Flag
+	PodSpecSecurityContext           Flag
+	PodSpecRuntimeClassName          Flag
+	PodSpecPriorityClassName         Flag
+
+	// RuntimeClassNameAllowlist restricts which runtime classes a Revision
+	// may request once the feature is enabled.  An empty set allows any.
+	RuntimeClassNameAllowlist sets.String
+	// PriorityClassNameAllowlist restricts which priority classes a Revision
+	// may request once the feature is enabled.  An empty set allows any.
+	PriorityClassNameAllowlist sets.String
+}
+
+func defaultFeaturesConfig() *Features {
+	return &Features{
+		PodSpecAffinity:                  Disabled,
+		PodSpecNodeSelector:              Disabled,
+		PodSpecTolerations:               Disabled,
+		PodSpecTopologySpreadConstraints: Disabled,
+		PodSpecHostAliases:               Disabled,
+		PodSpecSecurityContext:           Disabled,
+		PodSpecRuntimeClassName:          Disabled,
+		PodSpecPriorityClassName:         Disabled,
+	}
+}
+
+// NewFeaturesConfigFromMap creates a Features from the supplied Map
+func NewFeaturesConfigFromMap(data map[string]string) (*Features, error) {
+	nc := defaultFeaturesConfig()
+
+	for _, flag := range []struct {
+		key   string
+		field *Flag
+	}{{
+		key:   "kubernetes.podspec-affinity",
+		field: &nc.PodSpecAffinity,
+	}, {
+		key:   "kubernetes.podspec-nodeselector",
+		field: &nc.PodSpecNodeSelector,
+	}, {
+		key:   "kubernetes.podspec-tolerations",
+		field: &nc.PodSpecTolerations,
+	}, {
+		key:   "kubernetes.podspec-topologyspreadconstraints",
+		field: &nc.PodSpecTopologySpreadConstraints,
+	}, {
+		key:   "kubernetes.podspec-hostaliases",
+		field: &nc.PodSpecHostAliases,
+	}, {
+		key:   "kubernetes.podspec-securitycontext",
+		field: &nc.PodSpecSecurityContext,
+	}, {
+		key:   "kubernetes.podspec-runtimeclassname",
+		field: &nc.PodSpecRuntimeClassName,
+	}, {
+		key:   "kubernetes.podspec-priorityclassname",
+		field: &nc.PodSpecPriorityClassName,
+	}} {
+		raw, ok := data[flag.key]
+		if !ok {
+			continue
+		}
+		switch Flag(raw) {
+		case Enabled, Allowed, Disabled:
+			*flag.field = Flag(raw)
+		default:
+			return nil, fmt.Errorf("%s in %s ConfigMap must be Allowed, Enabled or Disabled, got %q",
+				flag.key, FeaturesConfigName, raw)
+		}
+	}
+
+	for _, allowlist := range []struct {
+		key   string
+		field *sets.String
+	}{{
+		key:   "kubernetes.podspec-runtimeclassname-allowlist",
+		field: &nc.RuntimeClassNameAllowlist,
+	}, {
+		key:   "kubernetes.podspec-priorityclassname-allowlist",
+		field: &nc.PriorityClassNameAllowlist,
+	}} {
+		raw, ok := data[allowlist.key]
+		if !ok {
+			continue
+		}
+		for _, name := range strings.Split(raw, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				if *allowlist.field == nil {
+					*allowlist.field = sets.NewString()
+				}
+				allowlist.field.Insert(name)
+			}
+		}
+	}
+
+	return nc, nil
+}
+
+// NewFeaturesConfigFromConfigMap creates a Features from the supplied ConfigMap
+func NewFeaturesConfigFromConfigMap(config *corev1.ConfigMap) (*Features, error) {
+	return NewFeaturesConfigFromMap(config.Data)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	. "knative.dev/pkg/configmap/testing"
+)
+
+func TestFeaturesConfigurationFromFile(t *testing.T) {
+	cm, example := ConfigMapsFromTestFile(t, FeaturesConfigName)
+
+	if _, err := NewFeaturesConfigFromConfigMap(cm); err != nil {
+		t.Errorf("NewFeaturesConfigFromConfigMap(actual) = %v", err)
+	}
+
+	if _, err := NewFeaturesConfigFromConfigMap(example); err != nil {
+		t.Errorf("NewFeaturesConfigFromConfigMap(example) = %v", err)
+	}
+}
+
+func TestFeaturesConfiguration(t *testing.T) {
+	configTests := []struct {
+		name         string
+		wantErr      bool
+		wantFeatures *Features
+		data         map[string]string
+	}{{
+		name:         "default configuration",
+		wantErr:      false,
+		wantFeatures: defaultFeaturesConfig(),
+		data:         map[string]string{},
+	}, {
+		name:    "features Enabled",
+		wantErr: false,
+		wantFeatures: &Features{
+			PodSpecAffinity:                  Enabled,
+			PodSpecNodeSelector:              Enabled,
+			PodSpecTolerations:               Enabled,
+			PodSpecTopologySpreadConstraints: Enabled,
+			PodSpecHostAliases:               Enabled,
+			PodSpecSecurityContext:           Enabled,
+			PodSpecRuntimeClassName:          Enabled,
+			PodSpecPriorityClassName:         Enabled,
+		},
+		data: map[string]string{
+			"kubernetes.podspec-affinity":                  "Enabled",
+			"kubernetes.podspec-nodeselector":              "Enabled",
+			"kubernetes.podspec-tolerations":               "Enabled",
+			"kubernetes.podspec-topologyspreadconstraints": "Enabled",
+			"kubernetes.podspec-hostaliases":               "Enabled",
+			"kubernetes.podspec-securitycontext":           "Enabled",
+			"kubernetes.podspec-runtimeclassname":          "Enabled",
+			"kubernetes.podspec-priorityclassname":         "Enabled",
+		},
+	}, {
+		name:    "class name allowlists",
+		wantErr: false,
+		wantFeatures: func() *Features {
+			f := defaultFeaturesConfig()
+			f.PodSpecRuntimeClassName = Enabled
+			f.PodSpecPriorityClassName = Enabled
+			f.RuntimeClassNameAllowlist = sets.NewString("gvisor", "kata")
+			f.PriorityClassNameAllowlist = sets.NewString("high-priority")
+			return f
+		}(),
+		data: map[string]string{
+			"kubernetes.podspec-runtimeclassname":            "Enabled",
+			"kubernetes.podspec-runtimeclassname-allowlist":  "gvisor, kata",
+			"kubernetes.podspec-priorityclassname":           "Enabled",
+			"kubernetes.podspec-priorityclassname-allowlist": "high-priority",
+		},
+	}, {
+		name:    "features Allowed",
+		wantErr: false,
+		wantFeatures: &Features{
+			PodSpecAffinity:                  Allowed,
+			PodSpecNodeSelector:              Disabled,
+			PodSpecTolerations:               Allowed,
+			PodSpecTopologySpreadConstraints: Disabled,
+			PodSpecHostAliases:               Disabled,
+			PodSpecSecurityContext:           Disabled,
+			PodSpecRuntimeClassName:          Disabled,
+			PodSpecPriorityClassName:         Disabled,
+		},
+		data: map[string]string{
+			"kubernetes.podspec-affinity":    "Allowed",
+			"kubernetes.podspec-tolerations": "Allowed",
+		},
+	}, {
+		name:    "invalid flag value",
+		wantErr: true,
+		data: map[string]string{
+			"kubernetes.podspec-affinity": "Wurst",
+		},
+	}}
+
+	for _, tt := range configTests {
+		t.Run(tt.name, func(t *testing.T) {
+			actualFeatures, err := NewFeaturesConfigFromMap(tt.data)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewFeaturesConfigFromMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if diff := cmp.Diff(tt.wantFeatures, actualFeatures); diff != "" {
+				t.Errorf("Unexpected features config (-want, +got): %v", diff)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ type cfgKey struct{}
 // +k8s:deepcopy-gen=false
 type Config struct {
 	Defaults *Defaults
+	Features *Features
 }
 
 // FromContext extracts a Config from the provided context.
@@ -46,8 +47,10 @@ func FromContextOrDefaults(ctx context.Context) *Config {
 		return cfg
 	}
 	defaults, _ := NewDefaultsConfigFromMap(map[string]string{})
+	features, _ := NewFeaturesConfigFromMap(map[string]string{})
 	return &Config{
 		Defaults: defaults,
+		Features: features,
 	}
 }
 
@@ -71,6 +74,7 @@ func NewStore(logger configmap.Logger, onAfterStore ...func(name string, value i
 			logger,
 			configmap.Constructors{
 				DefaultsConfigName: NewDefaultsConfigFromConfigMap,
+				FeaturesConfigName: NewFeaturesConfigFromConfigMap,
 			},
 			onAfterStore...,
 		),
@@ -86,7 +90,14 @@ func (s *Store) ToContext(ctx context.Context) context.Context {
 
 // Load creates a Config from the current config state of the Store.
 func (s *Store) Load() *Config {
+	// The config-features ConfigMap may not have been seen yet, in which
+	// case all features carry their default values.
+	features := defaultFeaturesConfig()
+	if f, ok := s.UntypedLoad(FeaturesConfigName).(*Features); ok {
+		features = f.DeepCopy()
+	}
 	return &Config{
 		Defaults: s.UntypedLoad(DefaultsConfigName).(*Defaults).DeepCopy(),
+		Features: features,
 	}
 }
@@ -35,6 +35,28 @@ const (
 	// Istio-based Ingress will reconcile into a VirtualService).
 	IngressClassAnnotationKey = "networking.knative.dev/ingress.class"
 
+	// DomainTemplateAnnotationKey is the annotation for overriding, on a
+	// per-Route (and hence per-namespace, when stamped by namespace policy)
+	// basis, the golang text template used to construct the Route's domain
+	// name.  For example,
+	//
+	//    networking.knative.dev/domainTemplate: "{{.Name}}-{{.Namespace}}.{{.Domain}}"
+	//
+	// This uses a different domain because unlike the resource, it is
+	// user-facing.  When unset, the domainTemplate from config-network is
+	// used.  Cluster-local Routes ignore this annotation.
+	DomainTemplateAnnotationKey = "networking.knative.dev/domainTemplate"
+
+	// VisibilityLabelKey is the label to indicate the visibility of a
+	// Route (and of the per-tag placeholder Services).  For example,
+	//
+	//    networking.knative.dev/visibility: cluster-local
+	//
+	// This uses a different domain because unlike the resource, it is
+	// user-facing.  It is equivalent to the serving.knative.dev/visibility
+	// label, which it supersedes.
+	VisibilityLabelKey = "networking.knative.dev/visibility"
+
 	// IngressLabelKey is the label key attached to underlying network programming
 	// resources to indicate which Ingress triggered their creation.
 	IngressLabelKey = GroupName + "/ingress"
@@ -213,6 +213,15 @@ type HTTPIngressPath struct {
 	// will be forwarded to.
 	Splits []IngressBackendSplit `json:"splits"`
 
+	// Headers defines the header matching rules of this path. A request
+	// matches this path only if all of the given headers carry the
+	// specified values.  Paths with header rules are evaluated before
+	// paths without.
+	//
+	// NOTE: This differs from K8s Ingress which doesn't allow header matching.
+	// +optional
+	Headers map[string]HeaderMatch `json:"headers,omitempty"`
+
 	// AppendHeaders allow specifying additional HTTP headers to add
 	// before forwarding a request to the destination service.
 	//
@@ -231,6 +240,20 @@ type HTTPIngressPath struct {
 	// NOTE: This differs from K8s Ingress which doesn't allow retry settings.
 	// +optional
 	Retries *HTTPRetry `json:"retries,omitempty"`
+
+	// RewriteHost rewrites the incoming request's host header to this value
+	// before forwarding it to the split backends.  This is useful to point
+	// a custom domain at an existing cluster-local hostname.
+	//
+	// NOTE: This differs from K8s Ingress which doesn't allow host rewriting.
+	// +optional
+	RewriteHost string `json:"rewriteHost,omitempty"`
+}
+
+// HeaderMatch represents a matching rule for a single HTTP request header.
+type HeaderMatch struct {
+	// Exact is the exact value the header must carry for the rule to match.
+	Exact string `json:"exact"`
 }
 
 // IngressBackendSplit describes all endpoints for a given service and port.
@@ -273,6 +296,11 @@ type HTTPRetry struct {
 
 	// Timeout per retry attempt for a given request. format: 1h/1m/1s/1ms. MUST BE >=1ms.
 	PerTryTimeout *metav1.Duration `json:"perTryTimeout"`
+
+	// RetriableStatusCodes lists HTTP status codes that should be retried in
+	// addition to connection-level failures.
+	// +optional
+	RetriableStatusCodes []int `json:"retriableStatusCodes,omitempty"`
 }
 
 // IngressStatus describe the current state of the Ingress.
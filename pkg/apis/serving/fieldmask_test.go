@@ -17,12 +17,14 @@ limitations under the License.
 package serving
 
 import (
+	"context"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"knative.dev/pkg/kmp"
 	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/config"
 )
 
 func TestVolumeMask(t *testing.T) {
@@ -117,7 +119,7 @@ func TestPodSpecMask(t *testing.T) {
 		}},
 	}
 
-	got := PodSpecMask(in)
+	got := PodSpecMask(context.Background(), in)
 
 	if &want == &got {
 		t.Error("Input and output share addresses. Want different addresses")
@@ -129,11 +131,61 @@ func TestPodSpecMask(t *testing.T) {
 		t.Errorf("PodSpecMask (-want, +got): %s", diff)
 	}
 
-	if got = PodSpecMask(nil); got != nil {
+	if got = PodSpecMask(context.Background(), nil); got != nil {
 		t.Errorf("PodSpecMask(nil) = %v, want: nil", got)
 	}
 }
 
+func TestPodSpecFeatureFieldsMask(t *testing.T) {
+	in := &corev1.PodSpec{
+		Containers: []corev1.Container{{
+			Image: "helloworld",
+		}},
+		Affinity: &corev1.Affinity{},
+		NodeSelector: map[string]string{
+			"kubernetes.io/arch": "amd64",
+		},
+		Tolerations: []corev1.Toleration{{
+			Key: "the-taint",
+		}},
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+			MaxSkew: 1,
+		}},
+		RuntimeClassName:  ptr.String("gvisor"),
+		PriorityClassName: "high-priority",
+	}
+
+	// With the feature flags at their default values the scheduling
+	// fields are stripped out.
+	want := &corev1.PodSpec{
+		Containers: []corev1.Container{{
+			Image: "helloworld",
+		}},
+	}
+	if diff, err := kmp.SafeDiff(want, PodSpecMask(context.Background(), in)); err != nil {
+		t.Errorf("Got error comparing output, err = %v", err)
+	} else if diff != "" {
+		t.Errorf("PodSpecMask (-want, +got): %s", diff)
+	}
+
+	// With the feature flags enabled the fields survive the mask.
+	ctx := config.ToContext(context.Background(), &config.Config{
+		Features: &config.Features{
+			PodSpecAffinity:                  config.Enabled,
+			PodSpecNodeSelector:              config.Enabled,
+			PodSpecTolerations:               config.Enabled,
+			PodSpecTopologySpreadConstraints: config.Enabled,
+			PodSpecRuntimeClassName:          config.Enabled,
+			PodSpecPriorityClassName:         config.Enabled,
+		},
+	})
+	if diff, err := kmp.SafeDiff(in, PodSpecMask(ctx, in)); err != nil {
+		t.Errorf("Got error comparing output, err = %v", err)
+	} else if diff != "" {
+		t.Errorf("PodSpecMask (-want, +got): %s", diff)
+	}
+}
+
 func TestContainerMask(t *testing.T) {
 	want := &corev1.Container{
 		Name:                     "foo",
@@ -17,6 +17,7 @@ limitations under the License.
 package serving
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"path/filepath"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/profiling"
+	"knative.dev/serving/pkg/apis/config"
 	"knative.dev/serving/pkg/apis/networking"
 )
 
@@ -236,14 +238,14 @@ func validateEnvFrom(envFromList []corev1.EnvFromSource) *apis.FieldError {
 	return errs
 }
 
-func ValidatePodSpec(ps corev1.PodSpec) *apis.FieldError {
+func ValidatePodSpec(ctx context.Context, ps corev1.PodSpec) *apis.FieldError {
 	// This is inlined, and so it makes for a less meaningful
 	// error message.
 	// if equality.Semantic.DeepEqual(ps, corev1.PodSpec{}) {
 	// 	return apis.ErrMissingField(apis.CurrentField)
 	// }
 
-	errs := apis.CheckDisallowedFields(ps, *PodSpecMask(&ps))
+	errs := apis.CheckDisallowedFields(ps, *PodSpecMask(ctx, &ps))
 
 	volumes, err := ValidateVolumes(ps.Volumes)
 	if err != nil {
@@ -264,9 +266,26 @@ func ValidatePodSpec(ps corev1.PodSpec) *apis.FieldError {
 			errs = errs.Also(apis.ErrInvalidValue("serviceAccountName", ps.ServiceAccountName))
 		}
 	}
+
+	features := config.FromContextOrDefaults(ctx).Features
+	if ps.RuntimeClassName != nil {
+		errs = errs.Also(validateClassAllowlist(*ps.RuntimeClassName, "runtimeClassName", features.RuntimeClassNameAllowlist))
+	}
+	if ps.PriorityClassName != "" {
+		errs = errs.Also(validateClassAllowlist(ps.PriorityClassName, "priorityClassName", features.PriorityClassNameAllowlist))
+	}
 	return errs
 }
 
+// validateClassAllowlist checks a class name requested on the PodSpec against
+// the allowlist the operator configured for it, if any.
+func validateClassAllowlist(name, field string, allowlist sets.String) *apis.FieldError {
+	if len(allowlist) > 0 && !allowlist.Has(name) {
+		return apis.ErrInvalidValue(name, field)
+	}
+	return nil
+}
+
 func ValidateContainer(container corev1.Container, volumes sets.String) *apis.FieldError {
 	if equality.Semantic.DeepEqual(container, corev1.Container{}) {
 		return apis.ErrMissingField(apis.CurrentField)
@@ -17,6 +17,7 @@ limitations under the License.
 package serving
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"testing"
@@ -28,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/config"
 )
 
 func TestPodSpecValidation(t *testing.T) {
@@ -183,7 +185,7 @@ func TestPodSpecValidation(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := ValidatePodSpec(test.ps)
+			got := ValidatePodSpec(context.Background(), test.ps)
 			if !cmp.Equal(test.want.Error(), got.Error()) {
 				t.Errorf("ValidatePodSpec (-want, +got) = %v",
 					cmp.Diff(test.want.Error(), got.Error()))
@@ -192,6 +194,99 @@ func TestPodSpecValidation(t *testing.T) {
 	}
 }
 
+func TestPodSpecFeatureValidation(t *testing.T) {
+	ps := corev1.PodSpec{
+		Containers: []corev1.Container{{
+			Image: "busybox",
+		}},
+		Affinity: &corev1.Affinity{},
+		NodeSelector: map[string]string{
+			"kubernetes.io/arch": "amd64",
+		},
+		Tolerations: []corev1.Toleration{{
+			Key:      "the-taint",
+			Operator: corev1.TolerationOpExists,
+		}},
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+			MaxSkew:           1,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+		}},
+	}
+
+	// With the flags at their defaults the scheduling fields are rejected.
+	want := apis.ErrDisallowedFields("affinity", "nodeSelector", "tolerations", "topologySpreadConstraints")
+	got := ValidatePodSpec(context.Background(), ps)
+	if !cmp.Equal(want.Error(), got.Error()) {
+		t.Errorf("ValidatePodSpec (-want, +got) = %v",
+			cmp.Diff(want.Error(), got.Error()))
+	}
+
+	// With the flags enabled they pass validation.
+	ctx := config.ToContext(context.Background(), &config.Config{
+		Features: &config.Features{
+			PodSpecAffinity:                  config.Enabled,
+			PodSpecNodeSelector:              config.Enabled,
+			PodSpecTolerations:               config.Enabled,
+			PodSpecTopologySpreadConstraints: config.Enabled,
+		},
+	})
+	if err := ValidatePodSpec(ctx, ps); err != nil {
+		t.Errorf("ValidatePodSpec() = %v, want: nil", err)
+	}
+}
+
+func TestPodSpecClassNameValidation(t *testing.T) {
+	ps := corev1.PodSpec{
+		Containers: []corev1.Container{{
+			Image: "busybox",
+		}},
+		RuntimeClassName:  ptr.String("gvisor"),
+		PriorityClassName: "high-priority",
+	}
+
+	ctx := config.ToContext(context.Background(), &config.Config{
+		Features: &config.Features{
+			PodSpecRuntimeClassName:  config.Enabled,
+			PodSpecPriorityClassName: config.Enabled,
+		},
+	})
+	// Without allowlists any class name is accepted.
+	if err := ValidatePodSpec(ctx, ps); err != nil {
+		t.Errorf("ValidatePodSpec() = %v, want: nil", err)
+	}
+
+	// Allowlists containing the requested names accept them.
+	ctx = config.ToContext(context.Background(), &config.Config{
+		Features: &config.Features{
+			PodSpecRuntimeClassName:    config.Enabled,
+			PodSpecPriorityClassName:   config.Enabled,
+			RuntimeClassNameAllowlist:  sets.NewString("gvisor"),
+			PriorityClassNameAllowlist: sets.NewString("high-priority"),
+		},
+	})
+	if err := ValidatePodSpec(ctx, ps); err != nil {
+		t.Errorf("ValidatePodSpec() = %v, want: nil", err)
+	}
+
+	// Class names outside the allowlists are rejected.
+	ctx = config.ToContext(context.Background(), &config.Config{
+		Features: &config.Features{
+			PodSpecRuntimeClassName:    config.Enabled,
+			PodSpecPriorityClassName:   config.Enabled,
+			RuntimeClassNameAllowlist:  sets.NewString("kata"),
+			PriorityClassNameAllowlist: sets.NewString("low-priority"),
+		},
+	})
+	want := apis.ErrInvalidValue("gvisor", "runtimeClassName").
+		Also(apis.ErrInvalidValue("high-priority", "priorityClassName"))
+	got := ValidatePodSpec(ctx, ps)
+	if !cmp.Equal(want.Error(), got.Error()) {
+		t.Errorf("ValidatePodSpec (-want, +got) = %v",
+			cmp.Diff(want.Error(), got.Error()))
+	}
+}
+
 func TestContainerValidation(t *testing.T) {
 	bidir := corev1.MountPropagationBidirectional
 
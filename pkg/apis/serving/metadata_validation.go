@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/validation"
@@ -34,7 +35,15 @@ var (
 	allowedAnnotations = map[string]struct{}{
 		UpdaterAnnotation:                {},
 		CreatorAnnotation:                {},
+		NoDefaultDomainAnnotationKey:     {},
+		ProgressDeadlineAnnotationKey:    {},
+		RejectionPolicyAnnotationKey:     {},
 		RevisionLastPinnedAnnotationKey:  {},
+		RevisionNoGCAnnotationKey:        {},
+		RetentionCountAnnotationKey:      {},
+		RoutesAnnotationKey:              {},
+		RollbackToAnnotationKey:          {},
+		RolloutDurationAnnotationKey:     {},
 		GroupNamePrefix + "forceUpgrade": {},
 	}
 )
@@ -76,14 +85,67 @@ func ValidateQueueSidecarAnnotation(annotations map[string]string) *apis.FieldEr
 	return nil
 }
 
+// ValidateProgressDeadlineAnnotation validates ProgressDeadlineAnnotationKey
+func ValidateProgressDeadlineAnnotation(annotations map[string]string) *apis.FieldError {
+	v, ok := annotations[ProgressDeadlineAnnotationKey]
+	if !ok {
+		return nil
+	}
+	if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+		return apis.ErrInvalidValue(v, apis.CurrentField).ViaKey(ProgressDeadlineAnnotationKey)
+	}
+	return nil
+}
+
+// ValidateRolloutDurationAnnotation validates RolloutDurationAnnotationKey
+func ValidateRolloutDurationAnnotation(annotations map[string]string) *apis.FieldError {
+	v, ok := annotations[RolloutDurationAnnotationKey]
+	if !ok {
+		return nil
+	}
+	if seconds, err := strconv.Atoi(v); err != nil || seconds < 0 {
+		return apis.ErrInvalidValue(v, apis.CurrentField).ViaKey(RolloutDurationAnnotationKey)
+	}
+	return nil
+}
+
+// ValidateRejectionPolicyAnnotation validates RejectionPolicyAnnotationKey
+func ValidateRejectionPolicyAnnotation(annotations map[string]string) *apis.FieldError {
+	v, ok := annotations[RejectionPolicyAnnotationKey]
+	if !ok {
+		return nil
+	}
+	switch v {
+	case RejectionPolicyRetryAfter, RejectionPolicyTooManyRequests, RejectionPolicyBlock:
+		return nil
+	}
+	return apis.ErrInvalidValue(v, apis.CurrentField).ViaKey(RejectionPolicyAnnotationKey)
+}
+
 // ValidateTimeoutSeconds validates timeout by comparing MaxRevisionTimeoutSeconds
 func ValidateTimeoutSeconds(ctx context.Context, timeoutSeconds int64) *apis.FieldError {
+	return validateTimeoutSeconds(ctx, timeoutSeconds, "timeoutSeconds")
+}
+
+// ValidateResponseStartTimeoutSeconds validates response start timeout by
+// comparing MaxRevisionTimeoutSeconds
+func ValidateResponseStartTimeoutSeconds(ctx context.Context, timeoutSeconds int64) *apis.FieldError {
+	return validateTimeoutSeconds(ctx, timeoutSeconds, "responseStartTimeoutSeconds")
+}
+
+// ValidateIdleTimeoutSeconds validates idle timeout by comparing
+// MaxRevisionTimeoutSeconds
+func ValidateIdleTimeoutSeconds(ctx context.Context, timeoutSeconds int64) *apis.FieldError {
+	return validateTimeoutSeconds(ctx, timeoutSeconds, "idleTimeoutSeconds")
+}
+
+func validateTimeoutSeconds(ctx context.Context, timeoutSeconds int64, fieldName string) *apis.FieldError {
 	if timeoutSeconds != 0 {
 		cfg := config.FromContextOrDefaults(ctx)
 		if timeoutSeconds > cfg.Defaults.MaxRevisionTimeoutSeconds || timeoutSeconds < 0 {
 			return apis.ErrOutOfBoundsValue(timeoutSeconds, 0,
 				cfg.Defaults.MaxRevisionTimeoutSeconds,
-				"timeoutSeconds")
+				fieldName)
 		}
 	}
 	return nil
@@ -102,9 +164,9 @@ func ValidateContainerConcurrency(containerConcurrency *int64) *apis.FieldError
 }
 
 // ValidateClusterVisibilityLabel function validates the visibility label on a Route
-func ValidateClusterVisibilityLabel(label string) (errs *apis.FieldError) {
+func ValidateClusterVisibilityLabel(label, key string) (errs *apis.FieldError) {
 	if label != VisibilityClusterLocal {
-		errs = apis.ErrInvalidValue(label, VisibilityLabelKey)
+		errs = apis.ErrInvalidValue(label, key)
 	}
 	return
 }
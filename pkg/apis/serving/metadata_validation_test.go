@@ -224,6 +224,144 @@ func TestValidateQueueSidecarAnnotation(t *testing.T) {
 	}
 }
 
+func TestValidateProgressDeadlineAnnotation(t *testing.T) {
+	cases := []struct {
+		name       string
+		annotation map[string]string
+		expectErr  error
+	}{{
+		name: "valid progress deadline annotation",
+		annotation: map[string]string{
+			ProgressDeadlineAnnotationKey: "66s",
+		},
+		expectErr: (*apis.FieldError)(nil),
+	}, {
+		name: "invalid progress deadline annotation",
+		annotation: map[string]string{
+			ProgressDeadlineAnnotationKey: "not-a-duration",
+		},
+		expectErr: &apis.FieldError{
+			Message: "invalid value: not-a-duration",
+			Paths:   []string{fmt.Sprintf("[%s]", ProgressDeadlineAnnotationKey)},
+		},
+	}, {
+		name: "non-positive progress deadline annotation",
+		annotation: map[string]string{
+			ProgressDeadlineAnnotationKey: "-1s",
+		},
+		expectErr: &apis.FieldError{
+			Message: "invalid value: -1s",
+			Paths:   []string{fmt.Sprintf("[%s]", ProgressDeadlineAnnotationKey)},
+		},
+	}, {
+		name:       "empty annotation",
+		annotation: map[string]string{},
+		expectErr:  (*apis.FieldError)(nil),
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := ValidateProgressDeadlineAnnotation(c.annotation)
+			if !reflect.DeepEqual(c.expectErr, err) {
+				t.Errorf("Expected: '%#v', Got: '%#v'", c.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateRolloutDurationAnnotation(t *testing.T) {
+	cases := []struct {
+		name       string
+		annotation map[string]string
+		expectErr  error
+	}{{
+		name: "valid rollout duration annotation",
+		annotation: map[string]string{
+			RolloutDurationAnnotationKey: "120",
+		},
+		expectErr: (*apis.FieldError)(nil),
+	}, {
+		name: "invalid rollout duration annotation",
+		annotation: map[string]string{
+			RolloutDurationAnnotationKey: "not-a-number",
+		},
+		expectErr: &apis.FieldError{
+			Message: "invalid value: not-a-number",
+			Paths:   []string{fmt.Sprintf("[%s]", RolloutDurationAnnotationKey)},
+		},
+	}, {
+		name: "negative rollout duration annotation",
+		annotation: map[string]string{
+			RolloutDurationAnnotationKey: "-10",
+		},
+		expectErr: &apis.FieldError{
+			Message: "invalid value: -10",
+			Paths:   []string{fmt.Sprintf("[%s]", RolloutDurationAnnotationKey)},
+		},
+	}, {
+		name:       "empty annotation",
+		annotation: map[string]string{},
+		expectErr:  (*apis.FieldError)(nil),
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := ValidateRolloutDurationAnnotation(c.annotation)
+			if !reflect.DeepEqual(c.expectErr, err) {
+				t.Errorf("Expected: '%#v', Got: '%#v'", c.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateRejectionPolicyAnnotation(t *testing.T) {
+	cases := []struct {
+		name       string
+		annotation map[string]string
+		expectErr  error
+	}{{
+		name: "valid retry-after annotation",
+		annotation: map[string]string{
+			RejectionPolicyAnnotationKey: RejectionPolicyRetryAfter,
+		},
+		expectErr: (*apis.FieldError)(nil),
+	}, {
+		name: "valid too-many-requests annotation",
+		annotation: map[string]string{
+			RejectionPolicyAnnotationKey: RejectionPolicyTooManyRequests,
+		},
+		expectErr: (*apis.FieldError)(nil),
+	}, {
+		name: "valid block annotation",
+		annotation: map[string]string{
+			RejectionPolicyAnnotationKey: RejectionPolicyBlock,
+		},
+		expectErr: (*apis.FieldError)(nil),
+	}, {
+		name: "invalid rejection policy annotation",
+		annotation: map[string]string{
+			RejectionPolicyAnnotationKey: "whenever",
+		},
+		expectErr: &apis.FieldError{
+			Message: "invalid value: whenever",
+			Paths:   []string{fmt.Sprintf("[%s]", RejectionPolicyAnnotationKey)},
+		},
+	}, {
+		name:       "empty annotation",
+		annotation: map[string]string{},
+		expectErr:  (*apis.FieldError)(nil),
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := ValidateRejectionPolicyAnnotation(c.annotation)
+			if !reflect.DeepEqual(c.expectErr, err) {
+				t.Errorf("Expected: '%#v', Got: '%#v'", c.expectErr, err)
+			}
+		})
+	}
+}
+
 func TestValidateTimeoutSecond(t *testing.T) {
 	cases := []struct {
 		name      string
@@ -302,7 +440,7 @@ func TestValidateClusterVisibilityLabel(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			err := ValidateClusterVisibilityLabel(test.label)
+			err := ValidateClusterVisibilityLabel(test.label, VisibilityLabelKey)
 			if !reflect.DeepEqual(test.expectErr, err) {
 				t.Errorf("ValidateClusterVisibilityLabel(%s) = %#v, Want: '%#v'", test.label, err, test.expectErr)
 			}
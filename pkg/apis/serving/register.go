@@ -33,6 +33,37 @@ const (
 	// pinned a revision
 	RevisionLastPinnedAnnotationKey = GroupName + "/lastPinned"
 
+	// RevisionNoGCAnnotationKey is the annotation key attached to a Revision
+	// to exempt it from garbage collection regardless of the cluster's
+	// config-gc settings. The only accepted value is "true".
+	RevisionNoGCAnnotationKey = GroupName + "/no-gc"
+
+	// NoDefaultDomainAnnotationKey is the annotation key attached to a Route
+	// (usually via its owning Service) to suppress generation of the default
+	// external hostname.  A Route annotated with "true" is only reachable on
+	// the cluster-local domain and through explicitly created DomainMappings.
+	NoDefaultDomainAnnotationKey = GroupName + "/no-default-domain"
+
+	// ProgressDeadlineAnnotationKey is the annotation key on a Revision
+	// overriding the duration its Deployment is allowed to make progress
+	// before the Revision is considered failed.
+	ProgressDeadlineAnnotationKey = GroupName + "/progress-deadline"
+
+	// RetentionCountAnnotationKey is the annotation key attached to a
+	// Configuration to override the cluster's minimum number of non-active
+	// Revisions retained for it.
+	RetentionCountAnnotationKey = GroupName + "/retention-count"
+
+	// RolloutDurationAnnotationKey is the annotation key on a Route overriding
+	// the number of seconds over which traffic is shifted to a Configuration's
+	// new latest ready Revision.
+	RolloutDurationAnnotationKey = GroupName + "/rolloutDuration"
+
+	// RollbackToAnnotationKey is the annotation key on a Service naming an
+	// older Revision that all traffic should be rewritten to, overriding the
+	// Service's traffic spec until the annotation is removed.
+	RollbackToAnnotationKey = GroupName + "/rollback-to"
+
 	// RouteLabelKey is the label key attached to a Configuration indicating by
 	// which Route it is configured as traffic target.
 	// The key can also be attached to Ingress resources to indicate
@@ -43,8 +74,17 @@ const (
 
 	// RouteNamespaceLabelKey is the label key attached to a Ingress
 	// by a Route to indicate which namespace the Route was created in.
+	// It is also matchable by config-domain label selectors, so that a
+	// domain suffix can be assigned to a whole namespace.
 	RouteNamespaceLabelKey = GroupName + "/routeNamespace"
 
+	// RoutesAnnotationKey is the annotation key attached to a Revision and
+	// Configuration indicating the comma-separated set of Routes referencing
+	// them. Unlike RouteLabelKey it tracks every referencing Route, so an
+	// element referenced by several Routes is not considered unreferenced
+	// when one of them moves away.
+	RoutesAnnotationKey = GroupName + "/routes"
+
 	// RevisionLabelKey is the label key attached to k8s resources to indicate
 	// which Revision triggered their creation.
 	RevisionLabelKey = GroupName + "/revision"
@@ -72,6 +112,31 @@ const (
 	// It has to be in [0.1,100]
 	QueueSideCarResourcePercentageAnnotation = "queue.sidecar." + GroupName + "/resourcePercentage"
 
+	// ContainerFreezeAnnotation is the annotation key a Revision uses to opt
+	// into signalling the cluster's concurrency state endpoint, which can
+	// freeze the user container's CPU while there are no in-flight requests.
+	// The only accepted value is "enabled".
+	ContainerFreezeAnnotation = "features." + GroupName + "/container-freeze"
+
+	// RejectionPolicyAnnotationKey is the annotation key on a Revision
+	// selecting how the queue proxy answers requests it cannot admit once
+	// containerConcurrency is exceeded and its queue is full.  The accepted
+	// values are RejectionPolicyRetryAfter, RejectionPolicyTooManyRequests
+	// and RejectionPolicyBlock.
+	RejectionPolicyAnnotationKey = GroupName + "/rejection-policy"
+
+	// RejectionPolicyRetryAfter rejects with a 503 carrying a Retry-After
+	// header, for clients that honor it.
+	RejectionPolicyRetryAfter = "retry-after"
+
+	// RejectionPolicyTooManyRequests rejects with a 429, for clients whose
+	// retry semantics key off that status.
+	RejectionPolicyTooManyRequests = "too-many-requests"
+
+	// RejectionPolicyBlock holds the request until capacity frees up or its
+	// timeout elapses instead of rejecting it.
+	RejectionPolicyBlock = "block"
+
 	// VisibilityLabelKey is the label to indicate visibility of Route
 	// and KServices.  It can be an annotation too but since users are
 	// already using labels for domain, it probably best to keep this
@@ -22,7 +22,7 @@ import (
 	"knative.dev/pkg/apis"
 )
 
-var configCondSet = apis.NewLivingConditionSet()
+var configCondSet = apis.NewLivingConditionSet(ConfigurationConditionLatestRevisionReady)
 
 // GetGroupVersionKind returns the GroupVersionKind.
 func (r *Configuration) GetGroupVersionKind() schema.GroupVersionKind {
@@ -58,34 +58,40 @@ func (cs *ConfigurationStatus) SetLatestCreatedRevisionName(name string) {
 	cs.LatestCreatedRevisionName = name
 	if cs.LatestReadyRevisionName != name {
 		configCondSet.Manage(cs).
-			MarkUnknown(ConfigurationConditionReady, "", "")
+			MarkUnknown(ConfigurationConditionLatestRevisionReady, "", "")
 	}
 }
 
 func (cs *ConfigurationStatus) SetLatestReadyRevisionName(name string) {
 	cs.LatestReadyRevisionName = name
 	if cs.LatestReadyRevisionName == cs.LatestCreatedRevisionName {
-		configCondSet.Manage(cs).MarkTrue(ConfigurationConditionReady)
+		configCondSet.Manage(cs).MarkTrue(ConfigurationConditionLatestRevisionReady)
 	}
 }
 
-func (cs *ConfigurationStatus) MarkLatestCreatedFailed(name, message string) {
+// MarkLatestCreatedFailed marks the configuration not ready, copying the
+// failing revision's reason and message into the LatestRevisionReady
+// condition. Routes keep serving the last healthy revision.
+func (cs *ConfigurationStatus) MarkLatestCreatedFailed(name, reason, message string) {
+	if reason == "" {
+		reason = "RevisionFailed"
+	}
 	configCondSet.Manage(cs).MarkFalse(
-		ConfigurationConditionReady,
-		"RevisionFailed",
+		ConfigurationConditionLatestRevisionReady,
+		reason,
 		"Revision %q failed with message: %s.", name, message)
 }
 
 func (cs *ConfigurationStatus) MarkRevisionCreationFailed(message string) {
 	configCondSet.Manage(cs).MarkFalse(
-		ConfigurationConditionReady,
+		ConfigurationConditionLatestRevisionReady,
 		"RevisionFailed",
 		"Revision creation failed with message: %s.", message)
 }
 
 func (cs *ConfigurationStatus) MarkLatestReadyDeleted() {
 	configCondSet.Manage(cs).MarkFalse(
-		ConfigurationConditionReady,
+		ConfigurationConditionLatestRevisionReady,
 		"RevisionDeleted",
 		"Revision %q was deleted.", cs.LatestReadyRevisionName)
 }
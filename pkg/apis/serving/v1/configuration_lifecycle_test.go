@@ -5,7 +5,7 @@ Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
 You may obtain a copy of the License at
 
-    http://www.apache.org/licenses/LICENSE-2.0
+	http://www.apache.org/licenses/LICENSE-2.0
 
 Unless required by applicable law or agreed to in writing, software
 distributed under the License is distributed on an "AS IS" BASIS,
@@ -232,11 +232,13 @@ func TestTypicalFlow(t *testing.T) {
 	r := &ConfigurationStatus{}
 	r.InitializeConditions()
 	apistest.CheckConditionOngoing(r, ConfigurationConditionReady, t)
+	apistest.CheckConditionOngoing(r, ConfigurationConditionLatestRevisionReady, t)
 	r.SetLatestCreatedRevisionName("foo")
 	apistest.CheckConditionOngoing(r, ConfigurationConditionReady, t)
 
 	r.SetLatestReadyRevisionName("foo")
 	apistest.CheckConditionSucceeded(r, ConfigurationConditionReady, t)
+	apistest.CheckConditionSucceeded(r, ConfigurationConditionLatestRevisionReady, t)
 
 	// Verify a second call to SetLatestCreatedRevisionName doesn't change the status from Ready
 	// e.g. on a subsequent reconciliation.
@@ -268,11 +270,16 @@ func TestFailingFirstRevisionWithRecovery(t *testing.T) {
 
 	// Then we create it, but it fails to come up.
 	const want2 = "the message"
-	r.MarkLatestCreatedFailed("foo", want2)
+	r.MarkLatestCreatedFailed("foo", "ExitCode1", want2)
 	apistest.CheckConditionFailed(r, ConfigurationConditionReady, t)
+	apistest.CheckConditionFailed(r, ConfigurationConditionLatestRevisionReady, t)
 	if c := r.GetCondition(ConfigurationConditionReady); !strings.Contains(c.Message, want2) {
 		t.Errorf("MarkLatestCreatedFailed = %v, want substring %v", c.Message, want2)
 	}
+	// The failing revision's reason is copied through.
+	if c := r.GetCondition(ConfigurationConditionLatestRevisionReady); c.Reason != "ExitCode1" {
+		t.Errorf("MarkLatestCreatedFailed reason = %v, want %v", c.Reason, "ExitCode1")
+	}
 
 	// When a new revision comes along the Ready condition becomes Unknown.
 	r.SetLatestCreatedRevisionName("bar")
@@ -299,8 +306,9 @@ func TestFailingSecondRevision(t *testing.T) {
 
 	// When the second revision fails, the Configuration becomes Failed.
 	const want = "the message"
-	r.MarkLatestCreatedFailed("bar", want)
+	r.MarkLatestCreatedFailed("bar", "RevisionFailed", want)
 	apistest.CheckConditionFailed(r, ConfigurationConditionReady, t)
+	apistest.CheckConditionFailed(r, ConfigurationConditionLatestRevisionReady, t)
 	if c := r.GetCondition(ConfigurationConditionReady); !strings.Contains(c.Message, want) {
 		t.Errorf("MarkLatestCreatedFailed = %v, want substring %v", c.Message, want)
 	}
@@ -68,10 +68,16 @@ const (
 	// ConfigurationConditionReady is set when the configuration's latest
 	// underlying revision has reported readiness.
 	ConfigurationConditionReady = apis.ConditionReady
+
+	// ConfigurationConditionLatestRevisionReady is set when the latest
+	// created revision has reported readiness. It surfaces a failed
+	// rollout distinctly, while Routes may keep serving an earlier
+	// healthy revision.
+	ConfigurationConditionLatestRevisionReady apis.ConditionType = "LatestRevisionReady"
 )
 
 func IsConfigurationCondition(t apis.ConditionType) bool {
-	return t == ConfigurationConditionReady
+	return t == ConfigurationConditionReady || t == ConfigurationConditionLatestRevisionReady
 }
 
 // ConfigurationStatusFields holds the fields of Configuration's status that
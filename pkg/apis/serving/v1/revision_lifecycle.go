@@ -38,6 +38,10 @@ const (
 	// unknown if the revision is still deploying.
 	ReasonDeploying = "Deploying"
 
+	// ReasonImageResolutionFailed defines the reason for marking container healthiness
+	// status as false if the tag of the container image could not be resolved to a digest.
+	ReasonImageResolutionFailed = "ImageResolutionFailed"
+
 	// ReasonNotOwned defines the reason for marking revision availability status as
 	// false due to resource ownership issues.
 	ReasonNotOwned = "NotOwned"
@@ -45,6 +49,10 @@ const (
 	// ReasonProgressDeadlineExceeded defines the reason for marking revision availability
 	// status as false if progress has exceeded the deadline.
 	ReasonProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+
+	// ReasonResolvingDigests defines the reason for marking revision availability status
+	// as unknown while the tag of the container image is being resolved to a digest.
+	ReasonResolvingDigests = "ResolvingDigests"
 )
 
 var revisionCondSet = apis.NewLivingConditionSet(
@@ -151,6 +159,16 @@ func (rs *RevisionStatus) PropagateAutoscalerStatus(ps *av1alpha1.PodAutoscalerS
 	// Propagate the service name from the PA.
 	rs.ServiceName = ps.ServiceName
 
+	// Propagate the scale counts, when the PA has computed them.
+	rs.ActualReplicas = nil
+	if ps.ActualScale != nil && *ps.ActualScale >= 0 {
+		rs.ActualReplicas = ps.ActualScale
+	}
+	rs.DesiredReplicas = nil
+	if ps.DesiredScale != nil && *ps.DesiredScale >= 0 {
+		rs.DesiredReplicas = ps.DesiredScale
+	}
+
 	// Reflect the PA status in our own.
 	cond := ps.GetCondition(av1alpha1.PodAutoscalerConditionReady)
 	if cond == nil {
@@ -706,6 +706,8 @@ func TestPropagateAutoscalerStatus(t *testing.T) {
 
 	// PodAutoscaler becomes ready, making us active.
 	r.PropagateAutoscalerStatus(&av1alpha1.PodAutoscalerStatus{
+		DesiredScale: ptr.Int32(1),
+		ActualScale:  ptr.Int32(1),
 		Status: duckv1.Status{
 			Conditions: duckv1.Conditions{{
 				Type:   av1alpha1.PodAutoscalerConditionReady,
@@ -715,6 +717,30 @@ func TestPropagateAutoscalerStatus(t *testing.T) {
 	})
 	apistest.CheckConditionSucceeded(r, RevisionConditionActive, t)
 	apistest.CheckConditionSucceeded(r, RevisionConditionReady, t)
+	if got, want := r.ActualReplicas, int32(1); got == nil || *got != want {
+		t.Errorf("ActualReplicas = %v, want %d", got, want)
+	}
+	if got, want := r.DesiredReplicas, int32(1); got == nil || *got != want {
+		t.Errorf("DesiredReplicas = %v, want %d", got, want)
+	}
+
+	// A negative scale means the PA has not computed one yet, so it is elided.
+	r.PropagateAutoscalerStatus(&av1alpha1.PodAutoscalerStatus{
+		DesiredScale: ptr.Int32(-1),
+		ActualScale:  ptr.Int32(-1),
+		Status: duckv1.Status{
+			Conditions: duckv1.Conditions{{
+				Type:   av1alpha1.PodAutoscalerConditionReady,
+				Status: corev1.ConditionTrue,
+			}},
+		},
+	})
+	if r.ActualReplicas != nil {
+		t.Errorf("ActualReplicas = %v, want nil", *r.ActualReplicas)
+	}
+	if r.DesiredReplicas != nil {
+		t.Errorf("DesiredReplicas = %v, want nil", *r.DesiredReplicas)
+	}
 
 	// PodAutoscaler flipping back to Unknown causes Active become ongoing immediately.
 	r.PropagateAutoscalerStatus(&av1alpha1.PodAutoscalerStatus{
@@ -84,6 +84,18 @@ type RevisionSpec struct {
 	// be provided.
 	// +optional
 	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// ResponseStartTimeoutSeconds holds the max duration the instance is
+	// allowed to respond with the first byte of a response.  If unspecified,
+	// the request timeout is used.
+	// +optional
+	ResponseStartTimeoutSeconds *int64 `json:"responseStartTimeoutSeconds,omitempty"`
+
+	// IdleTimeoutSeconds holds the max duration a request is allowed to stay
+	// open while not receiving any bytes from the user's application.  If
+	// unspecified, there is no idle timeout.
+	// +optional
+	IdleTimeoutSeconds *int64 `json:"idleTimeoutSeconds,omitempty"`
 }
 
 const (
@@ -136,6 +148,26 @@ type RevisionStatus struct {
 	// may be empty if the image comes from a registry listed to skip resolution.
 	// +optional
 	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// ContainerStatuses holds the resolved image digest for each container in
+	// .Spec.Containers, keyed by the container name. The digests are resolved
+	// during the creation of Revision.
+	// +optional
+	ContainerStatuses []ContainerStatus `json:"containerStatuses,omitempty"`
+
+	// ActualReplicas reflects the amount of ready pods running this revision.
+	// +optional
+	ActualReplicas *int32 `json:"actualReplicas,omitempty"`
+
+	// DesiredReplicas reflects the desired amount of pods running this revision.
+	// +optional
+	DesiredReplicas *int32 `json:"desiredReplicas,omitempty"`
+}
+
+// ContainerStatus holds the information of container name and image digest value
+type ContainerStatus struct {
+	Name        string `json:"name,omitempty"`
+	ImageDigest string `json:"imageDigest,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
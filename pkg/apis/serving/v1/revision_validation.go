@@ -63,6 +63,8 @@ func (rts *RevisionTemplateSpec) Validate(ctx context.Context) *apis.FieldError
 	// it follows the requirements on the name.
 	errs = errs.Also(serving.ValidateRevisionName(ctx, rts.Name, rts.GenerateName))
 	errs = errs.Also(serving.ValidateQueueSidecarAnnotation(rts.Annotations).ViaField("metadata.annotations"))
+	errs = errs.Also(serving.ValidateProgressDeadlineAnnotation(rts.Annotations).ViaField("metadata.annotations"))
+	errs = errs.Also(serving.ValidateRejectionPolicyAnnotation(rts.Annotations).ViaField("metadata.annotations"))
 	return errs
 }
 
@@ -96,12 +98,20 @@ func (current *RevisionTemplateSpec) VerifyNameChange(ctx context.Context, og Re
 
 // Validate implements apis.Validatable
 func (rs *RevisionSpec) Validate(ctx context.Context) *apis.FieldError {
-	errs := serving.ValidatePodSpec(rs.PodSpec)
+	errs := serving.ValidatePodSpec(ctx, rs.PodSpec)
 
 	if rs.TimeoutSeconds != nil {
 		errs = errs.Also(serving.ValidateTimeoutSeconds(ctx, *rs.TimeoutSeconds))
 	}
 
+	if rs.ResponseStartTimeoutSeconds != nil {
+		errs = errs.Also(serving.ValidateResponseStartTimeoutSeconds(ctx, *rs.ResponseStartTimeoutSeconds))
+	}
+
+	if rs.IdleTimeoutSeconds != nil {
+		errs = errs.Also(serving.ValidateIdleTimeoutSeconds(ctx, *rs.IdleTimeoutSeconds))
+	}
+
 	if rs.ContainerConcurrency != nil {
 		errs = errs.Also(serving.ValidateContainerConcurrency(rs.ContainerConcurrency).ViaField("containerConcurrency"))
 	}
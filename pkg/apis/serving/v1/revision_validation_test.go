@@ -432,6 +432,32 @@ func TestRevisionSpecValidation(t *testing.T) {
 		want: apis.ErrOutOfBoundsValue(
 			-30, 0, config.DefaultMaxRevisionTimeoutSeconds,
 			"timeoutSeconds"),
+	}, {
+		name: "exceed max response start timeout",
+		rs: &RevisionSpec{
+			PodSpec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Image: "helloworld",
+				}},
+			},
+			ResponseStartTimeoutSeconds: ptr.Int64(6000),
+		},
+		want: apis.ErrOutOfBoundsValue(
+			6000, 0, config.DefaultMaxRevisionTimeoutSeconds,
+			"responseStartTimeoutSeconds"),
+	}, {
+		name: "negative idle timeout",
+		rs: &RevisionSpec{
+			PodSpec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Image: "helloworld",
+				}},
+			},
+			IdleTimeoutSeconds: ptr.Int64(-30),
+		},
+		want: apis.ErrOutOfBoundsValue(
+			-30, 0, config.DefaultMaxRevisionTimeoutSeconds,
+			"idleTimeoutSeconds"),
 	}}
 
 	for _, test := range tests {
@@ -89,6 +89,13 @@ type TrafficTarget struct {
 	// +optional
 	LatestRevision *bool `json:"latestRevision,omitempty"`
 
+	// Headers optionally restricts this target to requests carrying all of
+	// the given headers with the given exact values.  Matching requests are
+	// routed to this target ahead of the percentage based splits, which
+	// allows dark-launching a candidate Revision with zero percent.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
 	// Percent indicates that percentage based routing should be used and
 	// the value indicates the percent of traffic that is be routed to this
 	// Revision or Configuration. `0` (zero) mean no traffic, `100` means all
@@ -113,6 +120,35 @@ type RouteSpec struct {
 	// revisions and configurations.
 	// +optional
 	Traffic []TrafficTarget `json:"traffic,omitempty"`
+
+	// Retries optionally configures how the ingress retries failed requests
+	// before a response reaches the client.  This is notably useful to
+	// absorb the transient 503s a Revision may return while it is scaling
+	// up from zero.
+	// +optional
+	Retries *RouteRetries `json:"retries,omitempty"`
+
+	// TimeoutSeconds optionally bounds the total time the ingress allows
+	// for a request, across all retry attempts.  When unset, the timeout
+	// of the slowest Revision being routed to is used.
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+}
+
+// RouteRetries describes the retry policy the ingress applies to requests
+// flowing through a Route.
+type RouteRetries struct {
+	// Attempts is the number of times the ingress retries a failed request.
+	Attempts int `json:"attempts"`
+
+	// PerTryTimeoutSeconds is the timeout applied to each individual attempt.
+	// +optional
+	PerTryTimeoutSeconds *int64 `json:"perTryTimeoutSeconds,omitempty"`
+
+	// RetriableStatusCodes lists HTTP status codes that trigger a retry in
+	// addition to connection-level failures.
+	// +optional
+	RetriableStatusCodes []int `json:"retriableStatusCodes,omitempty"`
 }
 
 const (
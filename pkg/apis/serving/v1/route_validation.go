@@ -23,6 +23,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
+	"knative.dev/serving/pkg/apis/networking"
 	"knative.dev/serving/pkg/apis/serving"
 )
 
@@ -30,6 +31,7 @@ import (
 func (r *Route) Validate(ctx context.Context) *apis.FieldError {
 	errs := serving.ValidateObjectMetadata(r.GetObjectMeta()).Also(
 		r.validateLabels().ViaField("labels")).ViaField("metadata")
+	errs = errs.Also(serving.ValidateRolloutDurationAnnotation(r.GetAnnotations()).ViaField("metadata.annotations"))
 	errs = errs.Also(r.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
 	errs = errs.Also(r.Status.Validate(apis.WithinStatus(ctx)).ViaField("status"))
 
@@ -89,7 +91,32 @@ func validateTrafficList(ctx context.Context, traffic []TrafficTarget) *apis.Fie
 
 // Validate implements apis.Validatable
 func (rs *RouteSpec) Validate(ctx context.Context) *apis.FieldError {
-	return validateTrafficList(ctx, rs.Traffic).ViaField("traffic")
+	errs := validateTrafficList(ctx, rs.Traffic).ViaField("traffic")
+	errs = errs.Also(rs.Retries.Validate(ctx).ViaField("retries"))
+	if rs.TimeoutSeconds != nil && *rs.TimeoutSeconds <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*rs.TimeoutSeconds, "timeoutSeconds"))
+	}
+	return errs
+}
+
+// Validate verifies that RouteRetries is properly configured.
+func (rr *RouteRetries) Validate(ctx context.Context) *apis.FieldError {
+	if rr == nil {
+		return nil
+	}
+	var errs *apis.FieldError
+	if rr.Attempts <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(rr.Attempts, "attempts"))
+	}
+	if rr.PerTryTimeoutSeconds != nil && *rr.PerTryTimeoutSeconds <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*rr.PerTryTimeoutSeconds, "perTryTimeoutSeconds"))
+	}
+	for i, code := range rr.RetriableStatusCodes {
+		if code < 100 || code > 599 {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(code, 100, 599, apis.CurrentField).ViaIndex(i).ViaField("retriableStatusCodes"))
+		}
+	}
+	return errs
 }
 
 // Validate verifies that TrafficTarget is properly configured.
@@ -97,9 +124,22 @@ func (tt *TrafficTarget) Validate(ctx context.Context) *apis.FieldError {
 	errs := tt.validateLatestRevision(ctx)
 	errs = tt.validateRevisionAndConfiguration(ctx, errs)
 	errs = tt.validateTrafficPercentage(errs)
+	errs = tt.validateHeaders(errs)
 	return tt.validateURL(ctx, errs)
 }
 
+func (tt *TrafficTarget) validateHeaders(errs *apis.FieldError) *apis.FieldError {
+	for name, value := range tt.Headers {
+		if el := validation.IsHTTPHeaderName(name); len(el) > 0 {
+			errs = errs.Also(apis.ErrInvalidKeyName(name, "headers", el...))
+		}
+		if value == "" {
+			errs = errs.Also(apis.ErrInvalidValue(value, apis.CurrentField).ViaKey(name).ViaField("headers"))
+		}
+	}
+	return errs
+}
+
 func (tt *TrafficTarget) validateRevisionAndConfiguration(ctx context.Context, errs *apis.FieldError) *apis.FieldError {
 	// We only validate the sense of latestRevision in the context of a Spec,
 	// and only when it is specified.
@@ -202,9 +242,9 @@ func (rsf *RouteStatusFields) Validate(ctx context.Context) *apis.FieldError {
 	return nil
 }
 
-func validateClusterVisibilityLabel(label string) (errs *apis.FieldError) {
+func validateClusterVisibilityLabel(label, key string) (errs *apis.FieldError) {
 	if label != serving.VisibilityClusterLocal {
-		errs = apis.ErrInvalidValue(label, serving.VisibilityLabelKey)
+		errs = apis.ErrInvalidValue(label, key)
 	}
 	return
 }
@@ -213,8 +253,8 @@ func validateClusterVisibilityLabel(label string) (errs *apis.FieldError) {
 func (r *Route) validateLabels() (errs *apis.FieldError) {
 	for key, val := range r.GetLabels() {
 		switch {
-		case key == serving.VisibilityLabelKey:
-			errs = errs.Also(validateClusterVisibilityLabel(val))
+		case key == serving.VisibilityLabelKey, key == networking.VisibilityLabelKey:
+			errs = errs.Also(validateClusterVisibilityLabel(val, key))
 		case key == serving.ServiceLabelKey:
 			errs = errs.Also(verifyLabelOwnerRef(val, serving.ServiceLabelKey, "Service", r.GetOwnerReferences()))
 		case strings.HasPrefix(key, serving.GroupNamePrefix):
@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/networking"
 	"knative.dev/serving/pkg/apis/serving"
 )
 
@@ -51,6 +52,40 @@ func TestTrafficTargetValidation(t *testing.T) {
 		},
 		wc:   apis.WithinSpec,
 		want: nil,
+	}, {
+		name: "valid with revisionName and headers",
+		tt: &TrafficTarget{
+			RevisionName: "bar",
+			Percent:      ptr.Int64(0),
+			Headers: map[string]string{
+				"Knative-Candidate": "yes",
+			},
+		},
+		wc:   apis.WithinSpec,
+		want: nil,
+	}, {
+		name: "invalid header name",
+		tt: &TrafficTarget{
+			RevisionName: "bar",
+			Percent:      ptr.Int64(0),
+			Headers: map[string]string{
+				"bad header": "yes",
+			},
+		},
+		wc: apis.WithinSpec,
+		want: apis.ErrInvalidKeyName("bad header", "headers",
+			"a valid HTTP header must consist of alphanumeric characters or '-' (e.g. 'X-Header-Name', regex used for validation is '[-A-Za-z0-9]+')"),
+	}, {
+		name: "invalid empty header value",
+		tt: &TrafficTarget{
+			RevisionName: "bar",
+			Percent:      ptr.Int64(0),
+			Headers: map[string]string{
+				"Knative-Candidate": "",
+			},
+		},
+		wc:   apis.WithinSpec,
+		want: apis.ErrInvalidValue("", apis.CurrentField).ViaKey("Knative-Candidate").ViaField("headers"),
 	}, {
 		name: "valid with revisionName and name (status)",
 		tt: &TrafficTarget{
@@ -363,6 +398,49 @@ func TestRouteValidation(t *testing.T) {
 			},
 		},
 		want: nil,
+	}, {
+		name: "valid retries and timeout",
+		r: &Route{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "valid",
+			},
+			Spec: RouteSpec{
+				Traffic: []TrafficTarget{{
+					RevisionName: "foo",
+					Percent:      ptr.Int64(100),
+				}},
+				Retries: &RouteRetries{
+					Attempts:             3,
+					PerTryTimeoutSeconds: ptr.Int64(10),
+					RetriableStatusCodes: []int{503},
+				},
+				TimeoutSeconds: ptr.Int64(30),
+			},
+		},
+		want: nil,
+	}, {
+		name: "invalid retries and timeout",
+		r: &Route{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "valid",
+			},
+			Spec: RouteSpec{
+				Traffic: []TrafficTarget{{
+					RevisionName: "foo",
+					Percent:      ptr.Int64(100),
+				}},
+				Retries: &RouteRetries{
+					Attempts:             0,
+					PerTryTimeoutSeconds: ptr.Int64(-1),
+					RetriableStatusCodes: []int{503, 700},
+				},
+				TimeoutSeconds: ptr.Int64(0),
+			},
+		},
+		want: apis.ErrInvalidValue(0, "spec.retries.attempts").Also(
+			apis.ErrInvalidValue(-1, "spec.retries.perTryTimeoutSeconds")).Also(
+			apis.ErrOutOfBoundsValue(700, 100, 599, "spec.retries.retriableStatusCodes[1]")).Also(
+			apis.ErrInvalidValue(0, "spec.timeoutSeconds")),
 	}, {
 		name: "missing url in status",
 		r: &Route{
@@ -540,6 +618,30 @@ func TestRouteLabelValidation(t *testing.T) {
 			Spec: validRouteSpec,
 		},
 		want: apis.ErrInvalidValue("bad-value", "metadata.labels.serving.knative.dev/visibility"),
+	}, {
+		name: "valid networking visibility name",
+		r: &Route{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "byo-name",
+				Labels: map[string]string{
+					networking.VisibilityLabelKey: "cluster-local",
+				},
+			},
+			Spec: validRouteSpec,
+		},
+		want: nil,
+	}, {
+		name: "invalid networking visibility name",
+		r: &Route{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "byo-name",
+				Labels: map[string]string{
+					networking.VisibilityLabelKey: "bad-value",
+				},
+			},
+			Spec: validRouteSpec,
+		},
+		want: apis.ErrInvalidValue("bad-value", "metadata.labels.networking.knative.dev/visibility"),
 	}, {
 		name: "valid knative service name",
 		r: &Route{
@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestDomainMappingDefaulting(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *DomainMapping
+		want *DomainMapping
+	}{{
+		name: "empty ref namespace defaulted to mapping namespace",
+		in: &DomainMapping{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mapping.example.com",
+				Namespace: "default",
+			},
+			Spec: DomainMappingSpec{
+				Ref: duckv1.KReference{
+					Name:       "some-name",
+					Kind:       "Service",
+					APIVersion: "serving.knative.dev/v1",
+				},
+			},
+		},
+		want: &DomainMapping{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mapping.example.com",
+				Namespace: "default",
+			},
+			Spec: DomainMappingSpec{
+				Ref: duckv1.KReference{
+					Name:       "some-name",
+					Namespace:  "default",
+					Kind:       "Service",
+					APIVersion: "serving.knative.dev/v1",
+				},
+			},
+		},
+	}, {
+		name: "explicit ref namespace is kept",
+		in: &DomainMapping{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mapping.example.com",
+				Namespace: "default",
+			},
+			Spec: DomainMappingSpec{
+				Ref: duckv1.KReference{
+					Name:       "some-name",
+					Namespace:  "other",
+					Kind:       "Service",
+					APIVersion: "serving.knative.dev/v1",
+				},
+			},
+		},
+		want: &DomainMapping{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mapping.example.com",
+				Namespace: "default",
+			},
+			Spec: DomainMappingSpec{
+				Ref: duckv1.KReference{
+					Name:       "some-name",
+					Namespace:  "other",
+					Kind:       "Service",
+					APIVersion: "serving.knative.dev/v1",
+				},
+			},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.in.DeepCopy()
+			got.SetDefaults(context.Background())
+			if !cmp.Equal(test.want, got) {
+				t.Errorf("SetDefaults (-want, +got) = %v",
+					cmp.Diff(test.want, got))
+			}
+		})
+	}
+}
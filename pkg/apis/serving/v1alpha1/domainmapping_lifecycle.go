@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/serving/pkg/apis/networking/v1alpha1"
+)
+
+var domainMappingCondSet = apis.NewLivingConditionSet(
+	DomainMappingConditionIngressReady,
+	DomainMappingConditionReferenceResolved,
+)
+
+func (dm *DomainMapping) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("DomainMapping")
+}
+
+func (dms *DomainMappingStatus) IsReady() bool {
+	return domainMappingCondSet.Manage(dms).IsHappy()
+}
+
+func (dms *DomainMappingStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return domainMappingCondSet.Manage(dms).GetCondition(t)
+}
+
+func (dms *DomainMappingStatus) InitializeConditions() {
+	domainMappingCondSet.Manage(dms).InitializeConditions()
+}
+
+// MarkReferenceResolved sets the ReferenceResolved condition to True.
+func (dms *DomainMappingStatus) MarkReferenceResolved() {
+	domainMappingCondSet.Manage(dms).MarkTrue(DomainMappingConditionReferenceResolved)
+}
+
+// MarkReferenceNotResolved sets the ReferenceResolved condition to False with
+// the reason the Ref could not be resolved to an address.
+func (dms *DomainMappingStatus) MarkReferenceNotResolved(msg string) {
+	domainMappingCondSet.Manage(dms).MarkFalse(DomainMappingConditionReferenceResolved,
+		"ResolveFailed", msg)
+}
+
+// MarkIngressNotConfigured changes the IngressReady condition to be unknown to
+// reflect that the Ingress does not yet have a Status.
+func (dms *DomainMappingStatus) MarkIngressNotConfigured() {
+	domainMappingCondSet.Manage(dms).MarkUnknown(DomainMappingConditionIngressReady,
+		"IngressNotConfigured", "Ingress has not yet been reconciled.")
+}
+
+func (dms *DomainMappingStatus) MarkCertificateProvisionFailed(name string) {
+	domainMappingCondSet.Manage(dms).SetCondition(apis.Condition{
+		Type:     DomainMappingConditionCertificateProvisioned,
+		Status:   corev1.ConditionFalse,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "CertificateProvisionFailed",
+		Message:  fmt.Sprintf("Certificate %s fails to be provisioned.", name),
+	})
+}
+
+func (dms *DomainMappingStatus) MarkCertificateReady(name string) {
+	domainMappingCondSet.Manage(dms).SetCondition(apis.Condition{
+		Type:     DomainMappingConditionCertificateProvisioned,
+		Status:   corev1.ConditionTrue,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "CertificateReady",
+		Message:  fmt.Sprintf("Certificate %s is successfully provisioned", name),
+	})
+}
+
+func (dms *DomainMappingStatus) MarkCertificateNotReady(name string) {
+	domainMappingCondSet.Manage(dms).SetCondition(apis.Condition{
+		Type:     DomainMappingConditionCertificateProvisioned,
+		Status:   corev1.ConditionUnknown,
+		Severity: apis.ConditionSeverityWarning,
+		Reason:   "CertificateNotReady",
+		Message:  fmt.Sprintf("Certificate %s is not ready.", name),
+	})
+}
+
+// PropagateIngressStatus updates the DomainMappingConditionIngressReady
+// condition according to the underlying Ingress's status.
+func (dms *DomainMappingStatus) PropagateIngressStatus(cs v1alpha1.IngressStatus) {
+	cc := cs.GetCondition(v1alpha1.IngressConditionReady)
+	if cc == nil {
+		dms.MarkIngressNotConfigured()
+		return
+	}
+
+	m := domainMappingCondSet.Manage(dms)
+	switch cc.Status {
+	case corev1.ConditionTrue:
+		m.MarkTrue(DomainMappingConditionIngressReady)
+	case corev1.ConditionFalse:
+		m.MarkFalse(DomainMappingConditionIngressReady, cc.Reason, cc.Message)
+	case corev1.ConditionUnknown:
+		m.MarkUnknown(DomainMappingConditionIngressReady, cc.Reason, cc.Message)
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2020 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	apistest "knative.dev/pkg/apis/testing"
+	netv1alpha1 "knative.dev/serving/pkg/apis/networking/v1alpha1"
+)
+
+func TestDomainMappingGetGroupVersionKind(t *testing.T) {
+	dm := &DomainMapping{}
+	expected := SchemeGroupVersion.WithKind("DomainMapping")
+	if got := dm.GetGroupVersionKind(); got != expected {
+		t.Errorf("GetGroupVersionKind() = %v, want: %v", got, expected)
+	}
+}
+
+func TestDomainMappingIsReady(t *testing.T) {
+	cases := []struct {
+		name    string
+		status  DomainMappingStatus
+		isReady bool
+	}{{
+		name:    "empty status should not be ready",
+		status:  DomainMappingStatus{},
+		isReady: false,
+	}, {
+		name: "Different condition type should not be ready",
+		status: DomainMappingStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{{
+					Type:   "Foo",
+					Status: corev1.ConditionTrue,
+				}},
+			},
+		},
+		isReady: false,
+	}, {
+		name: "False condition status should not be ready",
+		status: DomainMappingStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{{
+					Type:   DomainMappingConditionReady,
+					Status: corev1.ConditionFalse,
+				}},
+			},
+		},
+		isReady: false,
+	}, {
+		name: "Unknown condition status should not be ready",
+		status: DomainMappingStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{{
+					Type:   DomainMappingConditionReady,
+					Status: corev1.ConditionUnknown,
+				}},
+			},
+		},
+		isReady: false,
+	}, {
+		name: "True condition status should be ready",
+		status: DomainMappingStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{{
+					Type:   DomainMappingConditionReady,
+					Status: corev1.ConditionTrue,
+				}},
+			},
+		},
+		isReady: true,
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := tc.status.IsReady(), tc.isReady; got != want {
+				t.Errorf("IsReady() = %v, want: %v", got, want)
+			}
+		})
+	}
+}
+
+func TestTypicalDomainMappingFlow(t *testing.T) {
+	dms := &DomainMappingStatus{}
+	dms.InitializeConditions()
+	apistest.CheckConditionOngoing(dms, DomainMappingConditionReferenceResolved, t)
+	apistest.CheckConditionOngoing(dms, DomainMappingConditionIngressReady, t)
+	apistest.CheckConditionOngoing(dms, DomainMappingConditionReady, t)
+
+	dms.MarkReferenceResolved()
+	apistest.CheckConditionSucceeded(dms, DomainMappingConditionReferenceResolved, t)
+	apistest.CheckConditionOngoing(dms, DomainMappingConditionIngressReady, t)
+	apistest.CheckConditionOngoing(dms, DomainMappingConditionReady, t)
+
+	dms.PropagateIngressStatus(netv1alpha1.IngressStatus{
+		Status: duckv1.Status{
+			Conditions: duckv1.Conditions{{
+				Type:   netv1alpha1.IngressConditionReady,
+				Status: corev1.ConditionTrue,
+			}},
+		},
+	})
+	apistest.CheckConditionSucceeded(dms, DomainMappingConditionReferenceResolved, t)
+	apistest.CheckConditionSucceeded(dms, DomainMappingConditionIngressReady, t)
+	apistest.CheckConditionSucceeded(dms, DomainMappingConditionReady, t)
+}
+
+func TestDomainMappingRefNotResolvedFlow(t *testing.T) {
+	dms := &DomainMappingStatus{}
+	dms.InitializeConditions()
+	apistest.CheckConditionOngoing(dms, DomainMappingConditionReferenceResolved, t)
+	apistest.CheckConditionOngoing(dms, DomainMappingConditionReady, t)
+
+	dms.MarkReferenceNotResolved("no address in status")
+	apistest.CheckConditionFailed(dms, DomainMappingConditionReferenceResolved, t)
+	apistest.CheckConditionFailed(dms, DomainMappingConditionReady, t)
+}
+
+func TestDomainMappingIngressFailureRecovery(t *testing.T) {
+	dms := &DomainMappingStatus{}
+	dms.InitializeConditions()
+	dms.MarkReferenceResolved()
+
+	// Empty IngressStatus marks ingress not configured.
+	dms.PropagateIngressStatus(netv1alpha1.IngressStatus{})
+	apistest.CheckConditionOngoing(dms, DomainMappingConditionIngressReady, t)
+	apistest.CheckConditionOngoing(dms, DomainMappingConditionReady, t)
+
+	dms.PropagateIngressStatus(netv1alpha1.IngressStatus{
+		Status: duckv1.Status{
+			Conditions: duckv1.Conditions{{
+				Type:   netv1alpha1.IngressConditionReady,
+				Status: corev1.ConditionFalse,
+			}},
+		},
+	})
+	apistest.CheckConditionFailed(dms, DomainMappingConditionIngressReady, t)
+	apistest.CheckConditionFailed(dms, DomainMappingConditionReady, t)
+
+	dms.PropagateIngressStatus(netv1alpha1.IngressStatus{
+		Status: duckv1.Status{
+			Conditions: duckv1.Conditions{{
+				Type:   netv1alpha1.IngressConditionReady,
+				Status: corev1.ConditionTrue,
+			}},
+		},
+	})
+	apistest.CheckConditionSucceeded(dms, DomainMappingConditionIngressReady, t)
+	apistest.CheckConditionSucceeded(dms, DomainMappingConditionReady, t)
+}
+
+func TestDomainMappingCertificateConditions(t *testing.T) {
+	dms := &DomainMappingStatus{}
+	dms.InitializeConditions()
+
+	dms.MarkCertificateNotReady("cert")
+	apistest.CheckConditionOngoing(dms, DomainMappingConditionCertificateProvisioned, t)
+
+	dms.MarkCertificateProvisionFailed("cert")
+	apistest.CheckConditionFailed(dms, DomainMappingConditionCertificateProvisioned, t)
+
+	dms.MarkCertificateReady("cert")
+	apistest.CheckConditionSucceeded(dms, DomainMappingConditionCertificateProvisioned, t)
+}
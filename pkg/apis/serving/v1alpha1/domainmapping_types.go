@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DomainMapping is a mapping from a custom hostname to an Addressable.
+// It is used to point a custom domain at a Knative Service (or any other
+// Addressable) without editing the cluster-wide domain template.  The name
+// of the DomainMapping is the fully qualified domain name being mapped.
+type DomainMapping struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the DomainMapping.
+	// +optional
+	Spec DomainMappingSpec `json:"spec,omitempty"`
+
+	// Status is the current state of the DomainMapping.
+	// +optional
+	Status DomainMappingStatus `json:"status,omitempty"`
+}
+
+// Verify that DomainMapping adheres to the appropriate interfaces.
+var (
+	// Check that DomainMapping may be validated and defaulted.
+	_ apis.Validatable = (*DomainMapping)(nil)
+	_ apis.Defaultable = (*DomainMapping)(nil)
+
+	// Check that we can create OwnerReferences to a DomainMapping.
+	_ kmeta.OwnerRefable = (*DomainMapping)(nil)
+)
+
+// DomainMappingSpec describes the DomainMapping the user wishes to exist.
+type DomainMappingSpec struct {
+	// Ref points to an Addressable to which requests for the mapped domain
+	// are forwarded.  This is typically a Knative Service, but may be any
+	// resource with an address in its status.
+	Ref duckv1.KReference `json:"ref"`
+}
+
+// DomainMappingStatus describes the current state of the DomainMapping.
+type DomainMappingStatus struct {
+	duckv1.Status `json:",inline"`
+
+	// URL is the URL of this DomainMapping.
+	// +optional
+	URL *apis.URL `json:"url,omitempty"`
+
+	// Address holds the information needed for a DomainMapping to be the
+	// target of an event.
+	// +optional
+	Address *duckv1.Addressable `json:"address,omitempty"`
+}
+
+const (
+	// DomainMappingConditionReady is set when the DomainMapping is configured
+	// and the Ingress is ready.
+	DomainMappingConditionReady = apis.ConditionReady
+
+	// DomainMappingConditionIngressReady is set to False when the
+	// Ingress fails to become Ready.
+	DomainMappingConditionIngressReady apis.ConditionType = "IngressReady"
+
+	// DomainMappingConditionReferenceResolved is set to False when the
+	// DomainMapping's Ref cannot be resolved to an address.
+	DomainMappingConditionReferenceResolved apis.ConditionType = "ReferenceResolved"
+
+	// DomainMappingConditionCertificateProvisioned is set to False when the
+	// Knative Certificate fails to be provisioned for the DomainMapping.
+	DomainMappingConditionCertificateProvisioned apis.ConditionType = "CertificateProvisioned"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DomainMappingList is a list of DomainMapping resources
+type DomainMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []DomainMapping `json:"items"`
+}
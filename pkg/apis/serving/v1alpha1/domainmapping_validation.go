@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+// Validate makes sure that DomainMapping is properly configured.
+// Note that the usual object metadata validation does not apply here:
+// the name of a DomainMapping is the domain being mapped, which is a
+// FQDN rather than a DNS 1035 label.
+func (dm *DomainMapping) Validate(ctx context.Context) *apis.FieldError {
+	errs := dm.validateMappingName().ViaField("metadata.name")
+	errs = errs.Also(dm.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
+	return errs
+}
+
+// validateMappingName checks that the name of the DomainMapping, which is the
+// domain being mapped, looks like a fully qualified domain name.
+func (dm *DomainMapping) validateMappingName() *apis.FieldError {
+	name := dm.Name
+	if !strings.Contains(name, ".") {
+		return apis.ErrGeneric("invalid name: must be a fully qualified domain name", apis.CurrentField)
+	}
+	for _, label := range strings.Split(name, ".") {
+		if el := validation.IsDNS1123Label(label); len(el) > 0 {
+			return apis.ErrInvalidValue(name, apis.CurrentField)
+		}
+	}
+	return nil
+}
+
+// Validate makes sure the DomainMappingSpec is properly configured.
+func (spec *DomainMappingSpec) Validate(ctx context.Context) *apis.FieldError {
+	return spec.Ref.Validate(ctx).ViaField("ref")
+}
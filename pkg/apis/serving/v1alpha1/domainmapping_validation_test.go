@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestDomainMappingValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		dm   *DomainMapping
+		want *apis.FieldError
+	}{{
+		name: "valid",
+		dm: &DomainMapping{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mapping.example.com",
+				Namespace: "default",
+			},
+			Spec: DomainMappingSpec{
+				Ref: duckv1.KReference{
+					Name:       "some-name",
+					Namespace:  "default",
+					Kind:       "Service",
+					APIVersion: "serving.knative.dev/v1",
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "name is not a FQDN",
+		dm: &DomainMapping{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "not-a-domain",
+				Namespace: "default",
+			},
+			Spec: DomainMappingSpec{
+				Ref: duckv1.KReference{
+					Name:       "some-name",
+					Namespace:  "default",
+					Kind:       "Service",
+					APIVersion: "serving.knative.dev/v1",
+				},
+			},
+		},
+		want: apis.ErrGeneric("invalid name: must be a fully qualified domain name", "metadata.name"),
+	}, {
+		name: "name has an invalid label",
+		dm: &DomainMapping{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "under_score.example.com",
+				Namespace: "default",
+			},
+			Spec: DomainMappingSpec{
+				Ref: duckv1.KReference{
+					Name:       "some-name",
+					Namespace:  "default",
+					Kind:       "Service",
+					APIVersion: "serving.knative.dev/v1",
+				},
+			},
+		},
+		want: apis.ErrInvalidValue("under_score.example.com", "metadata.name"),
+	}, {
+		name: "ref is missing fields",
+		dm: &DomainMapping{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mapping.example.com",
+				Namespace: "default",
+			},
+			Spec: DomainMappingSpec{
+				Ref: duckv1.KReference{
+					Namespace: "default",
+				},
+			},
+		},
+		want: apis.ErrMissingField("spec.ref.apiVersion", "spec.ref.kind", "spec.ref.name"),
+	}, {
+		name: "ref in another namespace",
+		dm: &DomainMapping{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mapping.example.com",
+				Namespace: "default",
+			},
+			Spec: DomainMappingSpec{
+				Ref: duckv1.KReference{
+					Name:       "some-name",
+					Namespace:  "other",
+					Kind:       "Service",
+					APIVersion: "serving.knative.dev/v1",
+				},
+			},
+		},
+		want: &apis.FieldError{
+			Message: "mismatched namespaces",
+			Paths:   []string{"spec.ref.namespace"},
+			Details: `parent namespace: "default" does not match ref: "other"`,
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := apis.WithinParent(context.Background(), test.dm.ObjectMeta)
+			got := test.dm.Validate(ctx)
+			if !cmp.Equal(test.want.Error(), got.Error()) {
+				t.Errorf("Validate (-want, +got) = %v",
+					cmp.Diff(test.want.Error(), got.Error()))
+			}
+		})
+	}
+}
@@ -49,6 +49,12 @@ func (source *RevisionSpec) ConvertTo(ctx context.Context, sink *v1.RevisionSpec
 	if source.TimeoutSeconds != nil {
 		sink.TimeoutSeconds = ptr.Int64(*source.TimeoutSeconds)
 	}
+	if source.ResponseStartTimeoutSeconds != nil {
+		sink.ResponseStartTimeoutSeconds = ptr.Int64(*source.ResponseStartTimeoutSeconds)
+	}
+	if source.IdleTimeoutSeconds != nil {
+		sink.IdleTimeoutSeconds = ptr.Int64(*source.IdleTimeoutSeconds)
+	}
 	if source.ContainerConcurrency != nil {
 		sink.ContainerConcurrency = ptr.Int64(*source.ContainerConcurrency)
 	}
@@ -82,6 +88,9 @@ func (source *RevisionStatus) ConvertTo(ctx context.Context, sink *v1.RevisionSt
 	sink.ServiceName = source.ServiceName
 	sink.LogURL = source.LogURL
 	sink.ImageDigest = source.ImageDigest
+	sink.ContainerStatuses = source.ContainerStatuses
+	sink.ActualReplicas = source.ActualReplicas
+	sink.DesiredReplicas = source.DesiredReplicas
 }
 
 // ConvertFrom implements apis.Convertible
@@ -114,4 +123,7 @@ func (sink *RevisionStatus) ConvertFrom(ctx context.Context, source v1.RevisionS
 	sink.ServiceName = source.ServiceName
 	sink.LogURL = source.LogURL
 	sink.ImageDigest = source.ImageDigest
+	sink.ContainerStatuses = source.ContainerStatuses
+	sink.ActualReplicas = source.ActualReplicas
+	sink.DesiredReplicas = source.DesiredReplicas
 }
@@ -192,6 +192,20 @@ type RevisionStatus struct {
 	// may be empty if the image comes from a registry listed to skip resolution.
 	// +optional
 	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// ContainerStatuses holds the resolved image digest for each container in
+	// .Spec.Containers, keyed by the container name. The digests are resolved
+	// during the creation of Revision.
+	// +optional
+	ContainerStatuses []v1.ContainerStatus `json:"containerStatuses,omitempty"`
+
+	// ActualReplicas reflects the amount of ready pods running this revision.
+	// +optional
+	ActualReplicas *int32 `json:"actualReplicas,omitempty"`
+
+	// DesiredReplicas reflects the desired amount of pods running this revision.
+	// +optional
+	DesiredReplicas *int32 `json:"desiredReplicas,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -63,6 +63,8 @@ func (rt *RevisionTemplateSpec) Validate(ctx context.Context) *apis.FieldError {
 	// it follows the requirements on the name.
 	errs = errs.Also(serving.ValidateRevisionName(ctx, rt.Name, rt.GenerateName))
 	errs = errs.Also(serving.ValidateQueueSidecarAnnotation(rt.Annotations).ViaField("metadata.annotations"))
+	errs = errs.Also(serving.ValidateProgressDeadlineAnnotation(rt.Annotations).ViaField("metadata.annotations"))
+	errs = errs.Also(serving.ValidateRejectionPolicyAnnotation(rt.Annotations).ViaField("metadata.annotations"))
 	return errs
 }
 
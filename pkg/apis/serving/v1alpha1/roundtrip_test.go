@@ -56,6 +56,8 @@ func TestServingRoundTripTypesToAlphaHub(t *testing.T) {
 		&Revision{},
 		&Configuration{},
 		&Service{},
+		// DomainMapping only exists in v1alpha1, so it is its own hub.
+		&DomainMapping{},
 	)
 
 	fuzzerFuncs := fuzzer.MergeFuzzerFuncs(
@@ -22,6 +22,7 @@ import (
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+	"knative.dev/pkg/ptr"
 	v1 "knative.dev/serving/pkg/apis/serving/v1"
 	"knative.dev/serving/pkg/apis/serving/v1beta1"
 )
@@ -46,6 +47,10 @@ func (source *RouteSpec) ConvertTo(ctx context.Context, sink *v1.RouteSpec) erro
 			return err
 		}
 	}
+	sink.Retries = source.Retries.DeepCopy()
+	if source.TimeoutSeconds != nil {
+		sink.TimeoutSeconds = ptr.Int64(*source.TimeoutSeconds)
+	}
 	return nil
 }
 
@@ -107,6 +112,10 @@ func (sink *RouteSpec) ConvertFrom(ctx context.Context, source v1.RouteSpec) {
 	for i := range source.Traffic {
 		sink.Traffic[i].ConvertFrom(ctx, source.Traffic[i])
 	}
+	sink.Retries = source.Retries.DeepCopy()
+	if source.TimeoutSeconds != nil {
+		sink.TimeoutSeconds = ptr.Int64(*source.TimeoutSeconds)
+	}
 }
 
 // ConvertFrom helps implement apis.Convertible
@@ -91,6 +91,16 @@ type RouteSpec struct {
 	// Traffic specifies how to distribute traffic over a collection of Knative Serving Revisions and Configurations.
 	// +optional
 	Traffic []TrafficTarget `json:"traffic,omitempty"`
+
+	// Retries optionally configures how the ingress retries failed requests
+	// before a response reaches the client.
+	// +optional
+	Retries *v1.RouteRetries `json:"retries,omitempty"`
+
+	// TimeoutSeconds optionally bounds the total time the ingress allows
+	// for a request, across all retry attempts.
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
 }
 
 const (
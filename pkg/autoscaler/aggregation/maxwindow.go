@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregation
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// maxEntry is the maximum value recorded within a single bucket, tagged
+// with the bucket index so stale entries can be told apart from current
+// ones after the ring wraps around.
+type maxEntry struct {
+	value int32
+	index int
+}
+
+// MaxTimeWindow keeps the maximum value recorded over a sliding time
+// window, bucketed by granularity. It is used by the autoscaler to hold
+// scale-down decisions until the lower value has been sustained for the
+// whole window.
+type MaxTimeWindow struct {
+	mux     sync.RWMutex
+	entries []maxEntry
+	// lastIndex is the bucket index of the most recent Record call.
+	lastIndex int
+
+	granularity time.Duration
+}
+
+// NewMaxTimeWindow generates a new MaxTimeWindow with the given window
+// length and granularity.
+func NewMaxTimeWindow(window, granularity time.Duration) *MaxTimeWindow {
+	// Number of buckets is `window` divided by `granularity`, rounded up.
+	// e.g. 60s / 2s = 30.
+	nb := int(math.Ceil(float64(window) / float64(granularity)))
+	return &MaxTimeWindow{
+		entries:     make([]maxEntry, nb),
+		granularity: granularity,
+	}
+}
+
+// timeToIndex converts time to an integer that can be used for modulo
+// operations to find the index in the bucket list.
+func (t *MaxTimeWindow) timeToIndex(tm time.Time) int {
+	// I don't think this run in 2038 :-)
+	// NB: we need to divide by granularity, since it's a compressing mapping
+	// to buckets.
+	return int(tm.Unix()) / int(t.granularity.Seconds())
+}
+
+// Record stores the value in the bucket of the given time, keeping only
+// the maximum of all values recorded within that bucket.
+func (t *MaxTimeWindow) Record(now time.Time, value int32) {
+	idx := t.timeToIndex(now)
+
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	slot := idx % len(t.entries)
+	if t.entries[slot].index != idx {
+		// The slot held a value from a previous revolution of the ring,
+		// overwrite it.
+		t.entries[slot] = maxEntry{value: value, index: idx}
+	} else if value > t.entries[slot].value {
+		t.entries[slot].value = value
+	}
+	if idx > t.lastIndex {
+		t.lastIndex = idx
+	}
+}
+
+// Current returns the maximum value recorded within the window ending at
+// the last Record call, or 0 if nothing was recorded yet.
+func (t *MaxTimeWindow) Current() int32 {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+
+	max := int32(0)
+	for _, e := range t.entries {
+		// Skip entries that have fallen out of the window.
+		if e.index <= t.lastIndex-len(t.entries) {
+			continue
+		}
+		if e.value > max {
+			max = e.value
+		}
+	}
+	return max
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMaxTimeWindowSimple(t *testing.T) {
+	now := time.Now()
+	w := NewMaxTimeWindow(10*time.Second, granularity)
+
+	if got, want := w.Current(), int32(0); got != want {
+		t.Errorf("Empty window Current() = %d, want: %d", got, want)
+	}
+
+	w.Record(now, 10)
+	if got, want := w.Current(), int32(10); got != want {
+		t.Errorf("Current() = %d, want: %d", got, want)
+	}
+
+	// A lower value within the window does not win.
+	w.Record(now.Add(granularity), 5)
+	if got, want := w.Current(), int32(10); got != want {
+		t.Errorf("Current() = %d, want: %d", got, want)
+	}
+
+	// A higher value does.
+	w.Record(now.Add(2*granularity), 11)
+	if got, want := w.Current(), int32(11); got != want {
+		t.Errorf("Current() = %d, want: %d", got, want)
+	}
+}
+
+func TestMaxTimeWindowSameBucket(t *testing.T) {
+	now := time.Now().Truncate(granularity)
+	w := NewMaxTimeWindow(10*time.Second, granularity)
+
+	// Several records within the same bucket keep the maximum.
+	w.Record(now, 3)
+	w.Record(now, 9)
+	w.Record(now, 7)
+	if got, want := w.Current(), int32(9); got != want {
+		t.Errorf("Current() = %d, want: %d", got, want)
+	}
+}
+
+func TestMaxTimeWindowSlide(t *testing.T) {
+	now := time.Now().Truncate(granularity)
+	w := NewMaxTimeWindow(4*time.Second, granularity)
+
+	w.Record(now, 10)
+	// Sustain the lower value for the whole window, so the old
+	// maximum slides out.
+	for i := 1; i <= 4; i++ {
+		w.Record(now.Add(time.Duration(i)*granularity), 2)
+	}
+	if got, want := w.Current(), int32(2); got != want {
+		t.Errorf("Current() = %d, want: %d", got, want)
+	}
+
+	// Going all the way down to zero works as well.
+	for i := 5; i <= 9; i++ {
+		w.Record(now.Add(time.Duration(i)*granularity), 0)
+	}
+	if got, want := w.Current(), int32(0); got != want {
+		t.Errorf("Current() = %d, want: %d", got, want)
+	}
+}
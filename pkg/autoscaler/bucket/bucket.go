@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bucket partitions the set of Revisions into a fixed number of
+// buckets, so that multiple autoscaler replicas can each own a subset of
+// the Revisions instead of a single replica handling everything.
+package bucket
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strconv"
+)
+
+const (
+	// autoscalerBucketFmt is the name format of Autoscaler buckets. The
+	// names double as the names of the Leases tracking bucket ownership
+	// and the Services through which the owners are reachable.
+	autoscalerBucketFmt = "autoscaler-bucket-%02d-of-%02d"
+
+	// countEnvName is the environment variable configuring the total
+	// number of Autoscaler buckets.
+	countEnvName = "AUTOSCALER_BUCKET_COUNT"
+
+	// podIPEnvName is the environment variable holding the IP of this pod.
+	podIPEnvName = "POD_IP"
+)
+
+// AutoscalerBucketName returns the name of the Autoscaler bucket with the
+// given ordinal and total count of buckets.
+func AutoscalerBucketName(ordinal, total uint32) string {
+	return fmt.Sprintf(autoscalerBucketFmt, ordinal, total)
+}
+
+// AutoscalerBucketSet returns a BucketSet consisting of the given total
+// count of Autoscaler buckets.
+func AutoscalerBucketSet(total uint32) *BucketSet {
+	buckets := make([]string, 0, total)
+	for i := uint32(0); i < total; i++ {
+		buckets = append(buckets, AutoscalerBucketName(i, total))
+	}
+	return NewBucketSet(buckets)
+}
+
+// NewBucketSet returns a BucketSet with the given bucket names.
+func NewBucketSet(buckets []string) *BucketSet {
+	return &BucketSet{buckets: buckets}
+}
+
+// Count returns the total number of Autoscaler buckets, configured via
+// the AUTOSCALER_BUCKET_COUNT environment variable. A missing value
+// defaults to 1, i.e. a single autoscaler replica owning everything.
+func Count() (uint32, error) {
+	v := os.Getenv(countEnvName)
+	if v == "" {
+		return 1, nil
+	}
+	c, err := strconv.ParseUint(v, 10, 32)
+	if err != nil || c < 1 {
+		return 0, fmt.Errorf("%s must be a positive integer, got %q", countEnvName, v)
+	}
+	return uint32(c), nil
+}
+
+// PodIP returns the IP of this pod from the POD_IP environment variable.
+func PodIP() (string, error) {
+	ip := os.Getenv(podIPEnvName)
+	if ip == "" {
+		return "", fmt.Errorf("%s environment variable not set", podIPEnvName)
+	}
+	return ip, nil
+}
+
+// BucketSet answers which bucket owns a given key. The assignment is
+// stable for a fixed total count of buckets, so all replicas (and the
+// activator) agree on the owner without any coordination.
+type BucketSet struct {
+	buckets []string
+}
+
+// Owner returns the name of the bucket owning the given key.
+func (b *BucketSet) Owner(key string) string {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return b.buckets[h.Sum32()%uint32(len(b.buckets))]
+}
+
+// HasBucket returns true if the given bucket is part of this set.
+func (b *BucketSet) HasBucket(bkt string) bool {
+	for _, b := range b.buckets {
+		if b == bkt {
+			return true
+		}
+	}
+	return false
+}
+
+// BucketList returns the names of all buckets in this set, in ordinal order.
+func (b *BucketSet) BucketList() []string {
+	return append(b.buckets[:0:0], b.buckets...)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"os"
+	"testing"
+)
+
+func TestAutoscalerBucketName(t *testing.T) {
+	if got, want := AutoscalerBucketName(0, 1), "autoscaler-bucket-00-of-01"; got != want {
+		t.Errorf("AutoscalerBucketName(0, 1) = %q, want: %q", got, want)
+	}
+	if got, want := AutoscalerBucketName(11, 20), "autoscaler-bucket-11-of-20"; got != want {
+		t.Errorf("AutoscalerBucketName(11, 20) = %q, want: %q", got, want)
+	}
+}
+
+func TestAutoscalerBucketSet(t *testing.T) {
+	bs := AutoscalerBucketSet(3)
+	want := []string{
+		"autoscaler-bucket-00-of-03",
+		"autoscaler-bucket-01-of-03",
+		"autoscaler-bucket-02-of-03",
+	}
+	got := bs.BucketList()
+	if len(got) != len(want) {
+		t.Fatalf("BucketList() = %v, want: %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("BucketList()[%d] = %q, want: %q", i, got[i], want[i])
+		}
+		if !bs.HasBucket(want[i]) {
+			t.Errorf("HasBucket(%q) = false, want: true", want[i])
+		}
+	}
+	if bs.HasBucket("autoscaler-bucket-00-of-02") {
+		t.Error(`HasBucket("autoscaler-bucket-00-of-02") = true, want: false`)
+	}
+}
+
+func TestOwner(t *testing.T) {
+	bs := AutoscalerBucketSet(5)
+	seen := map[string]struct{}{}
+	for _, key := range []string{"default/rev-1", "default/rev-2", "foo/rev-1", "bar/baz"} {
+		first := bs.Owner(key)
+		if !bs.HasBucket(first) {
+			t.Errorf("Owner(%q) = %q, not a bucket of the set", key, first)
+		}
+		// Ownership must be stable.
+		for i := 0; i < 10; i++ {
+			if got := bs.Owner(key); got != first {
+				t.Errorf("Owner(%q) = %q, want: %q", key, got, first)
+			}
+		}
+		seen[first] = struct{}{}
+	}
+	if len(seen) < 2 {
+		t.Errorf("All keys hashed to a single bucket, want some spread, got: %v", seen)
+	}
+}
+
+func TestCount(t *testing.T) {
+	defer os.Unsetenv(countEnvName)
+
+	os.Unsetenv(countEnvName)
+	if got, err := Count(); err != nil || got != 1 {
+		t.Errorf("Count() = %d, %v, want: 1, no error", got, err)
+	}
+
+	os.Setenv(countEnvName, "3")
+	if got, err := Count(); err != nil || got != 3 {
+		t.Errorf("Count() = %d, %v, want: 3, no error", got, err)
+	}
+
+	for _, v := range []string{"0", "-1", "not-a-number"} {
+		os.Setenv(countEnvName, v)
+		if _, err := Count(); err == nil {
+			t.Errorf("Count() with %s=%q did not fail", countEnvName, v)
+		}
+	}
+}
+
+func TestPodIP(t *testing.T) {
+	defer os.Unsetenv(podIPEnvName)
+
+	os.Unsetenv(podIPEnvName)
+	if _, err := PodIP(); err == nil {
+		t.Error("PodIP() with no environment variable did not fail")
+	}
+
+	os.Setenv(podIPEnvName, "1.2.3.4")
+	if got, err := PodIP(); err != nil || got != "1.2.3.4" {
+		t.Errorf("PodIP() = %q, %v, want: 1.2.3.4, no error", got, err)
+	}
+}
@@ -48,6 +48,14 @@ type Config struct {
 	// Enable connection-aware pod scaledown
 	EnableGracefulScaledown bool
 
+	// AllowZeroInitialScale indicates whether InitialScale and
+	// autoscaling.knative.dev/initialScale are allowed to be set to 0.
+	AllowZeroInitialScale bool
+
+	// InitialScale is the cluster-wide default initial revision size.
+	// Can be overridden by the autoscaling.knative.dev/initialScale annotation.
+	InitialScale int32
+
 	// Target concurrency knobs for different container concurrency configurations.
 	ContainerConcurrencyTargetFraction float64
 	ContainerConcurrencyTargetDefault  float64
@@ -70,6 +78,12 @@ type Config struct {
 	PanicThresholdPercentage float64
 	TickInterval             time.Duration
 
+	// ScaleDownDelay is the time that must pass at reduced concurrency
+	// before a scale-down decision is applied. Zero means reduce
+	// immediately. Can be overridden by the
+	// autoscaling.knative.dev/scaleDownDelay annotation.
+	ScaleDownDelay time.Duration
+
 	ScaleToZeroGracePeriod time.Duration
 }
 
@@ -94,6 +108,11 @@ func NewConfigFromMap(data map[string]string) (*Config, error) {
 			key:          "enable-graceful-scaledown",
 			field:        &lc.EnableGracefulScaledown,
 			defaultValue: false,
+		},
+		{
+			key:          "allow-zero-initial-scale",
+			field:        &lc.AllowZeroInitialScale,
+			defaultValue: false,
 		}} {
 		if raw, ok := data[b.key]; !ok {
 			*b.field = b.defaultValue
@@ -159,6 +178,15 @@ func NewConfigFromMap(data map[string]string) (*Config, error) {
 		lc.ContainerConcurrencyTargetFraction /= 100.0
 	}
 
+	// Process int32 fields.
+	if raw, ok := data["initial-scale"]; !ok {
+		lc.InitialScale = 1
+	} else if val, err := strconv.ParseInt(raw, 10, 32); err != nil {
+		return nil, err
+	} else {
+		lc.InitialScale = int32(val)
+	}
+
 	// Process Duration fields
 	for _, dur := range []struct {
 		key          string
@@ -176,6 +204,10 @@ func NewConfigFromMap(data map[string]string) (*Config, error) {
 		key:          "tick-interval",
 		field:        &lc.TickInterval,
 		defaultValue: 2 * time.Second,
+	}, {
+		key:          "scale-down-delay",
+		field:        &lc.ScaleDownDelay,
+		defaultValue: 0,
 	}} {
 		if raw, ok := data[dur.key]; !ok {
 			*dur.field = dur.defaultValue
@@ -193,6 +225,10 @@ func validate(lc *Config) (*Config, error) {
 	if lc.ScaleToZeroGracePeriod < autoscaling.WindowMin {
 		return nil, fmt.Errorf("scale-to-zero-grace-period must be at least %v, got %v", autoscaling.WindowMin, lc.ScaleToZeroGracePeriod)
 	}
+	if lc.InitialScale < 0 || (lc.InitialScale == 0 && !lc.AllowZeroInitialScale) {
+		return nil, fmt.Errorf("initial-scale = %v, must be at least 0 (or at least 1 when allow-zero-initial-scale is false)", lc.InitialScale)
+	}
+
 	if lc.TargetBurstCapacity < 0 && lc.TargetBurstCapacity != -1 {
 		return nil, fmt.Errorf("target-burst-capacity must be non-negative, got %f", lc.TargetBurstCapacity)
 	}
@@ -217,6 +253,16 @@ func validate(lc *Config) (*Config, error) {
 		return nil, fmt.Errorf("max-scale-down-rate = %v, must be greater than 1.0", lc.MaxScaleDownRate)
 	}
 
+	if lc.ScaleDownDelay < 0 {
+		return nil, fmt.Errorf("scale-down-delay cannot be negative, got %v", lc.ScaleDownDelay)
+	}
+	if lc.ScaleDownDelay > autoscaling.WindowMax {
+		return nil, fmt.Errorf("scale-down-delay = %v, must be at most %v", lc.ScaleDownDelay, autoscaling.WindowMax)
+	}
+	if lc.ScaleDownDelay.Round(time.Second) != lc.ScaleDownDelay {
+		return nil, fmt.Errorf("scale-down-delay = %v, must be specified with at most second precision", lc.ScaleDownDelay)
+	}
+
 	// We can't permit stable window be less than our aggregation window for correctness.
 	if lc.StableWindow < autoscaling.WindowMin {
 		return nil, fmt.Errorf("stable-window = %v, must be at least %v", lc.StableWindow, autoscaling.WindowMin)
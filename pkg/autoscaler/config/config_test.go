@@ -29,6 +29,8 @@ import (
 var defaultConfig = Config{
 	EnableScaleToZero:                  true,
 	EnableGracefulScaledown:            false,
+	AllowZeroInitialScale:              false,
+	InitialScale:                       1,
 	ContainerConcurrencyTargetFraction: 0.7,
 	ContainerConcurrencyTargetDefault:  100,
 	RPSTargetDefault:                   200,
@@ -91,6 +93,71 @@ func TestNewConfig(t *testing.T) {
 			c.TargetBurstCapacity = -1
 			return &c
 		}(defaultConfig),
+	}, {
+		name: "with scale-down-delay",
+		input: map[string]string{
+			"scale-down-delay": "15m",
+		},
+		want: func(c Config) *Config {
+			c.ScaleDownDelay = 15 * time.Minute
+			return &c
+		}(defaultConfig),
+	}, {
+		name: "with negative scale-down-delay",
+		input: map[string]string{
+			"scale-down-delay": "-1s",
+		},
+		wantErr: true,
+	}, {
+		name: "with sub-second scale-down-delay",
+		input: map[string]string{
+			"scale-down-delay": "500ms",
+		},
+		wantErr: true,
+	}, {
+		name: "with too long scale-down-delay",
+		input: map[string]string{
+			"scale-down-delay": "2h",
+		},
+		wantErr: true,
+	}, {
+		name: "with explicit initial scale",
+		input: map[string]string{
+			"initial-scale": "2",
+		},
+		want: func(c Config) *Config {
+			c.InitialScale = 2
+			return &c
+		}(defaultConfig),
+	}, {
+		name: "with zero initial scale, not allowed",
+		input: map[string]string{
+			"initial-scale": "0",
+		},
+		wantErr: true,
+	}, {
+		name: "with zero initial scale, allowed",
+		input: map[string]string{
+			"allow-zero-initial-scale": "true",
+			"initial-scale":            "0",
+		},
+		want: func(c Config) *Config {
+			c.AllowZeroInitialScale = true
+			c.InitialScale = 0
+			return &c
+		}(defaultConfig),
+	}, {
+		name: "with negative initial scale",
+		input: map[string]string{
+			"initial-scale": "-1",
+		},
+		wantErr: true,
+	}, {
+		name: "with non-parseable initial scale",
+		input: map[string]string{
+			"initial-scale": "invalid",
+		},
+		wantErr: true,
 	}, {
 		name: "with default toggles set",
 		input: map[string]string{
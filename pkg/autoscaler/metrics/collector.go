@@ -70,6 +70,16 @@ type Stat struct {
 
 	// Process uptime in seconds.
 	ProcessUptime float64
+
+	// Current number of requests in the serving and waiting queue of the pod.
+	QueueDepth float64
+
+	// Average time in milliseconds requests spent in the queue before being
+	// served since last Stat.
+	AverageQueueingLatency float64
+
+	// Number of requests rejected since last Stat because the queue was full.
+	RejectedRequestCount float64
 }
 
 var emptyStat = Stat{}
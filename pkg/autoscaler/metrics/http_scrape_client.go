@@ -86,7 +86,10 @@ func extractData(body io.Reader) (Stat, error) {
 	}
 	// Transitional metrics, which older pods won't report.
 	for m, pv := range map[string]*float64{
-		"process_uptime": &stat.ProcessUptime, // Can be removed after 0.15 cuts.
+		"process_uptime":                     &stat.ProcessUptime, // Can be removed after 0.15 cuts.
+		"queue_depth":                        &stat.QueueDepth,
+		"queue_average_queueing_latency_ms":  &stat.AverageQueueingLatency,
+		"queue_requests_rejected_per_second": &stat.RejectedRequestCount,
 	} {
 		pm := prometheusMetric(metricFamilies, m)
 		// Ignore if not found.
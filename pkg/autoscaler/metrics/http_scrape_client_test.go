@@ -53,7 +53,21 @@ queue_proxied_operations_per_second{destination_namespace="test-namespace",desti
 process_uptime{destination_configuration="s1",destination_namespace="default",destination_pod="s1-tdgpn-deployment-86f6459cf8-mc9mw",destination_revision="s1-tdgpn"} 2937.12
 `
 
-	testOptionalContext = testFullContext + testUptimeContext
+	testQueueDepthContext = `# HELP queue_depth Number of requests in the serving and waiting queue of this pod
+# TYPE queue_depth gauge
+queue_depth{destination_configuration="s1",destination_namespace="default",destination_pod="s1-tdgpn-deployment-86f6459cf8-mc9mw",destination_revision="s1-tdgpn"} 6
+`
+	testQueueingLatencyContext = `# HELP queue_average_queueing_latency_ms Average time in milliseconds requests spent in the queue before being served
+# TYPE queue_average_queueing_latency_ms gauge
+queue_average_queueing_latency_ms{destination_configuration="s1",destination_namespace="default",destination_pod="s1-tdgpn-deployment-86f6459cf8-mc9mw",destination_revision="s1-tdgpn"} 12.5
+`
+	testRejectedContext = `# HELP queue_requests_rejected_per_second Number of requests per second rejected because the queue was full
+# TYPE queue_requests_rejected_per_second gauge
+queue_requests_rejected_per_second{destination_configuration="s1",destination_namespace="default",destination_pod="s1-tdgpn-deployment-86f6459cf8-mc9mw",destination_revision="s1-tdgpn"} 0.5
+`
+
+	testOptionalContext = testFullContext + testUptimeContext +
+		testQueueDepthContext + testQueueingLatencyContext + testRejectedContext
 )
 
 func TestNewHTTPScrapeClient_ErrorCases(t *testing.T) {
@@ -141,6 +155,15 @@ func TestHTTPScrapeClientScrapeHappyCaseWithOptionals(t *testing.T) {
 	if got, want := stat.ProcessUptime, 2937.12; got != want {
 		t.Errorf("stat.ProcessUptime = %v, want: %v", got, want)
 	}
+	if got, want := stat.QueueDepth, 6.0; got != want {
+		t.Errorf("stat.QueueDepth = %v, want: %v", got, want)
+	}
+	if got, want := stat.AverageQueueingLatency, 12.5; got != want {
+		t.Errorf("stat.AverageQueueingLatency = %v, want: %v", got, want)
+	}
+	if got, want := stat.RejectedRequestCount, 0.5; got != want {
+		t.Errorf("stat.RejectedRequestCount = %v, want: %v", got, want)
+	}
 }
 
 func TestHTTPScrapeClient_Scrape_ErrorCases(t *testing.T) {
@@ -19,8 +19,10 @@ package metrics
 import (
 	"errors"
 	"fmt"
+	"math/rand"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -55,8 +57,43 @@ var (
 	// ErrDidNotReceiveStat specifies the error returned by scraper when it does not receive
 	// stat from an unscraped pod
 	ErrDidNotReceiveStat = errors.New("did not receive stat from an unscraped pod")
+
+	// errDirectScrapingNotAvailable is returned by scrapePods when not a
+	// single pod could be scraped directly, which is the signature of a
+	// mesh blocking pod-to-pod traffic.
+	errDirectScrapingNotAvailable = errors.New("all direct pod scrapes failed")
 )
 
+// MeshCompatibilityMode determines whether the scraper reaches the pods
+// directly or goes through the Revision's K8S service, which a strict mTLS
+// mesh handles transparently.
+type MeshCompatibilityMode string
+
+const (
+	// MeshCompatibilityModeAuto scrapes pods directly and permanently falls
+	// back to scraping via the Revision's K8S service once not a single pod
+	// could be reached, e.g. because a service mesh enforces strict mTLS
+	// between pods.
+	MeshCompatibilityModeAuto MeshCompatibilityMode = "auto"
+	// MeshCompatibilityModeEnabled always scrapes via the Revision's K8S
+	// service, trading scrape accuracy for mesh compatibility.
+	MeshCompatibilityModeEnabled MeshCompatibilityMode = "enabled"
+	// MeshCompatibilityModeDisabled always scrapes pods directly.
+	MeshCompatibilityModeDisabled MeshCompatibilityMode = "disabled"
+)
+
+// ParseMeshCompatibilityMode converts the given string into a
+// MeshCompatibilityMode, defaulting to auto for the empty string.
+func ParseMeshCompatibilityMode(mode string) (MeshCompatibilityMode, error) {
+	switch MeshCompatibilityMode(mode) {
+	case "":
+		return MeshCompatibilityModeAuto, nil
+	case MeshCompatibilityModeAuto, MeshCompatibilityModeEnabled, MeshCompatibilityModeDisabled:
+		return MeshCompatibilityMode(mode), nil
+	}
+	return "", fmt.Errorf("unsupported mesh compatibility mode: %q", mode)
+}
+
 // StatsScraper defines the interface for collecting Revision metrics
 type StatsScraper interface {
 	// Scrape scrapes the Revision queue metric endpoint. The duration is used
@@ -81,29 +118,43 @@ var cacheDisabledClient = &http.Client{
 	Timeout: httpClientTimeout,
 }
 
-// ServiceScraper scrapes Revision metrics via a K8S service by sampling. Which
-// pod to be picked up to serve the request is decided by K8S. Please see
+// ServiceScraper scrapes Revision metrics by sampling. By default the pods
+// are scraped directly; if that is impossible, e.g. because a mesh enforces
+// strict mTLS between pods, the scrapes go through the Revision's K8S
+// service instead. Which pod is picked up to serve such a request is decided
+// by K8S. Please see
 // https://kubernetes.io/docs/concepts/services-networking/network-policies/
 // for details.
 type ServiceScraper struct {
-	sClient scrapeClient
-	counter resources.EndpointsCounter
-	url     string
+	sClient  scrapeClient
+	counter  resources.EndpointsCounter
+	addrs    resources.EndpointsAddressGetter
+	url      string
+	meshMode MeshCompatibilityMode
+
+	// podsAddressable is flipped to false once direct scraping proved
+	// impossible, so all subsequent scrapes go through the service right
+	// away. Scrape is never called concurrently for the same scraper, so
+	// plain reads and writes suffice.
+	podsAddressable bool
 }
 
 // NewServiceScraper creates a new StatsScraper for the Revision which
 // the given Metric is responsible for.
-func NewServiceScraper(metric *av1alpha1.Metric, counter resources.EndpointsCounter) (*ServiceScraper, error) {
+func NewServiceScraper(metric *av1alpha1.Metric, counter resources.EndpointsCounter,
+	addrs resources.EndpointsAddressGetter, meshMode MeshCompatibilityMode) (*ServiceScraper, error) {
 	sClient, err := newHTTPScrapeClient(cacheDisabledClient)
 	if err != nil {
 		return nil, err
 	}
-	return newServiceScraperWithClient(metric, counter, sClient)
+	return newServiceScraperWithClient(metric, counter, addrs, meshMode, sClient)
 }
 
 func newServiceScraperWithClient(
 	metric *av1alpha1.Metric,
 	counter resources.EndpointsCounter,
+	addrs resources.EndpointsAddressGetter,
+	meshMode MeshCompatibilityMode,
 	sClient scrapeClient) (*ServiceScraper, error) {
 	if metric == nil {
 		return nil, errors.New("metric must not be nil")
@@ -111,6 +162,9 @@ func newServiceScraperWithClient(
 	if counter == nil {
 		return nil, errors.New("counter must not be nil")
 	}
+	if addrs == nil {
+		return nil, errors.New("address getter must not be nil")
+	}
 	if sClient == nil {
 		return nil, errors.New("scrape client must not be nil")
 	}
@@ -120,9 +174,12 @@ func newServiceScraperWithClient(
 	}
 
 	return &ServiceScraper{
-		sClient: sClient,
-		counter: counter,
-		url:     urlFromTarget(metric.Spec.ScrapeTarget, metric.ObjectMeta.Namespace),
+		sClient:         sClient,
+		counter:         counter,
+		addrs:           addrs,
+		url:             urlFromTarget(metric.Spec.ScrapeTarget, metric.ObjectMeta.Namespace),
+		meshMode:        meshMode,
+		podsAddressable: true,
 	}, nil
 }
 
@@ -132,9 +189,85 @@ func urlFromTarget(t, ns string) string {
 		t, ns, networking.AutoscalingQueueMetricsPort)
 }
 
-// Scrape calls the destination service then sends it
-// to the given stats channel.
+func urlFromIP(ip string) string {
+	return fmt.Sprintf("http://%s:%d/metrics", ip, networking.AutoscalingQueueMetricsPort)
+}
+
+// Scrape samples the queue metric endpoints of the Revision's pods and
+// returns the aggregated Stat. Depending on the mesh compatibility mode the
+// pods are scraped directly or via the Revision's K8S service.
 func (s *ServiceScraper) Scrape(window time.Duration) (Stat, error) {
+	if s.meshMode != MeshCompatibilityModeEnabled && s.podsAddressable {
+		stat, err := s.scrapePods()
+		if s.meshMode == MeshCompatibilityModeDisabled || !errors.Is(err, errDirectScrapingNotAvailable) {
+			return stat, err
+		}
+		// Not a single pod could be reached directly, presumably because a
+		// mesh enforces mTLS between pods. Scrape through the service from
+		// now on, which the mesh handles transparently.
+		s.podsAddressable = false
+	}
+	return s.scrapeService(window)
+}
+
+// scrapePods samples the pods of the Revision directly, using the ready
+// addresses of the Revision's endpoints. Young pods are not filtered here,
+// as every pod is sampled at most once and thus there is no older pod whose
+// stat could stand in for a young one's.
+func (s *ServiceScraper) scrapePods() (Stat, error) {
+	pods, err := s.addrs.ReadyAddresses()
+	if err != nil {
+		return emptyStat, ErrFailedGetEndpoints
+	}
+
+	readyPodsCount := len(pods)
+	if readyPodsCount == 0 {
+		return emptyStat, nil
+	}
+	rand.Shuffle(readyPodsCount, func(i, j int) { pods[i], pods[j] = pods[j], pods[i] })
+
+	sampleSize := populationMeanSampleSize(readyPodsCount)
+	statCh := make(chan Stat, sampleSize)
+
+	grp := errgroup.Group{}
+	idx := int32(-1)
+	for i := 0; i < sampleSize; i++ {
+		grp.Go(func() error {
+			// Each goroutine keeps drawing addresses from the shuffled list
+			// until one scrape succeeds or the list is exhausted. Since every
+			// address is drawn at most once, all the scraped pods are distinct.
+			for {
+				myIdx := int(atomic.AddInt32(&idx, 1))
+				if myIdx >= readyPodsCount {
+					return errDirectScrapingNotAvailable
+				}
+				stat, err := s.sClient.Scrape(urlFromIP(pods[myIdx]))
+				if err != nil {
+					continue
+				}
+				statCh <- stat
+				return nil
+			}
+		})
+	}
+	err = grp.Wait()
+	close(statCh)
+	if err != nil {
+		if len(statCh) == 0 {
+			return emptyStat, errDirectScrapingNotAvailable
+		}
+		return emptyStat, fmt.Errorf("unsuccessful pod scrape, sampleSize=%d: %w", sampleSize, err)
+	}
+
+	stats := make([]Stat, 0, sampleSize)
+	for stat := range statCh {
+		stats = append(stats, stat)
+	}
+	return aggregateStats(stats, readyPodsCount), nil
+}
+
+// scrapeService samples the pods of the Revision through its K8S service.
+func (s *ServiceScraper) scrapeService(window time.Duration) (Stat, error) {
 	readyPodsCount, err := s.counter.ReadyCount()
 	if err != nil {
 		return emptyStat, ErrFailedGetEndpoints
@@ -202,43 +335,58 @@ func (s *ServiceScraper) Scrape(window time.Duration) (Stat, error) {
 	close(oldStatCh)
 	close(youngStatCh)
 
+	stats := make([]Stat, 0, sampleSize)
+	for stat := range oldStatCh {
+		stats = append(stats, stat)
+	}
+	for i := len(stats); i < sampleSize; i++ {
+		// This will always succeed, see reasoning above.
+		stats = append(stats, <-youngStatCh)
+	}
+	return aggregateStats(stats, readyPodsCount), nil
+}
+
+// aggregateStats combines the sampled stats into the single Stat reported to
+// the autoscaler, extrapolating to the given number of ready pods.
+//
+// Assumption: A particular pod can stand for other pods, i.e. other pods
+// have similar concurrency and QPS.
+//
+// Hide the actual pods behind scraper and send only one stat for all the
+// customer pods per scraping. The pod name is set to a unique value, i.e.
+// scraperPodName so in autoscaler all stats are either from activator or
+// scraper.
+func aggregateStats(stats []Stat, readyPodsCount int) Stat {
 	var (
 		avgConcurrency        float64
 		avgProxiedConcurrency float64
 		reqCount              float64
 		proxiedReqCount       float64
+		queueDepth            float64
+		avgQueueingLatency    float64
+		rejectedReqCount      float64
 	)
 
-	oldCnt := len(oldStatCh)
-	for stat := range oldStatCh {
-		avgConcurrency += stat.AverageConcurrentRequests
-		avgProxiedConcurrency += stat.AverageProxiedConcurrentRequests
-		reqCount += stat.RequestCount
-		proxiedReqCount += stat.ProxiedRequestCount
-	}
-	for i := oldCnt; i < sampleSize; i++ {
-		// This will always succeed, see reasoning above.
-		stat := <-youngStatCh
+	for _, stat := range stats {
 		avgConcurrency += stat.AverageConcurrentRequests
 		avgProxiedConcurrency += stat.AverageProxiedConcurrentRequests
 		reqCount += stat.RequestCount
 		proxiedReqCount += stat.ProxiedRequestCount
+		queueDepth += stat.QueueDepth
+		avgQueueingLatency += stat.AverageQueueingLatency
+		rejectedReqCount += stat.RejectedRequestCount
 	}
 
-	count := float64(sampleSize)
+	count := float64(len(stats))
 	frpc := float64(readyPodsCount)
 	avgConcurrency = avgConcurrency / count
 	avgProxiedConcurrency = avgProxiedConcurrency / count
 	reqCount = reqCount / count
 	proxiedReqCount = proxiedReqCount / count
+	queueDepth = queueDepth / count
+	avgQueueingLatency = avgQueueingLatency / count
+	rejectedReqCount = rejectedReqCount / count
 
-	// Assumption: A particular pod can stand for other pods, i.e. other pods
-	// have similar concurrency and QPS.
-	//
-	// Hide the actual pods behind scraper and send only one stat for all the
-	// customer pods per scraping. The pod name is set to a unique value, i.e.
-	// scraperPodName so in autoscaler all stats are either from activator or
-	// scraper.
 	return Stat{
 		Time:                             time.Now(),
 		PodName:                          scraperPodName,
@@ -246,7 +394,12 @@ func (s *ServiceScraper) Scrape(window time.Duration) (Stat, error) {
 		AverageProxiedConcurrentRequests: avgProxiedConcurrency * frpc,
 		RequestCount:                     reqCount * frpc,
 		ProxiedRequestCount:              proxiedReqCount * frpc,
-	}, nil
+		QueueDepth:                       queueDepth * frpc,
+		// Queueing latency is an average, not a rate, so it isn't
+		// extrapolated to the number of ready pods.
+		AverageQueueingLatency: avgQueueingLatency,
+		RejectedRequestCount:   rejectedReqCount * frpc,
+	}
 }
 
 // tryScrape runs a single scrape and returns stat if this is a pod that has not been
@@ -76,7 +76,7 @@ func testStatsWithTime(n int, youngestSecs float64) []Stat {
 
 func TestNewServiceScraperWithClientHappyCase(t *testing.T) {
 	client := newTestScrapeClient(testStats, []error{nil})
-	if scraper, err := serviceScraperForTest(client); err != nil {
+	if scraper, err := serviceScraperForTest(client, MeshCompatibilityModeAuto); err != nil {
 		t.Fatalf("serviceScraperForTest=%v, want no error", err)
 	} else if scraper.url != testURL {
 		t.Errorf("scraper.url=%v, want %v", scraper.url, testURL)
@@ -90,40 +90,53 @@ func TestNewServiceScraperWithClientErrorCases(t *testing.T) {
 	client := newTestScrapeClient(testStats, []error{nil})
 	lister := fake.KubeInformer.Core().V1().Endpoints().Lister()
 	counter := resources.NewScopedEndpointsCounter(lister, fake.TestNamespace, fake.TestService)
+	addrs := resources.NewScopedEndpointsAddressGetter(lister, fake.TestNamespace, fake.TestService)
 
 	testCases := []struct {
 		name        string
 		metric      *av1alpha1.Metric
 		client      scrapeClient
 		counter     resources.EndpointsCounter
+		addrs       resources.EndpointsAddressGetter
 		expectedErr string
 	}{{
 		name:        "Empty Decider",
 		client:      client,
 		counter:     counter,
+		addrs:       addrs,
 		expectedErr: "metric must not be nil",
 	}, {
 		name:        "Missing revision label in Decider",
 		metric:      invalidMetric,
 		client:      client,
 		counter:     counter,
+		addrs:       addrs,
 		expectedErr: "no Revision label found for Metric test-revision",
 	}, {
 		name:        "Empty scrape client",
 		metric:      metric,
 		counter:     counter,
+		addrs:       addrs,
 		expectedErr: "scrape client must not be nil",
 	}, {
 		name:        "Empty lister",
 		metric:      metric,
 		client:      client,
 		counter:     nil,
+		addrs:       addrs,
 		expectedErr: "counter must not be nil",
+	}, {
+		name:        "Empty address getter",
+		metric:      metric,
+		client:      client,
+		counter:     counter,
+		addrs:       nil,
+		expectedErr: "address getter must not be nil",
 	}}
 
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			if _, err := newServiceScraperWithClient(test.metric, test.counter, test.client); err != nil {
+			if _, err := newServiceScraperWithClient(test.metric, test.counter, test.addrs, MeshCompatibilityModeAuto, test.client); err != nil {
 				got := err.Error()
 				want := test.expectedErr
 				if got != want {
@@ -138,7 +151,7 @@ func TestNewServiceScraperWithClientErrorCases(t *testing.T) {
 
 func TestScrapeReportStatWhenAllCallsSucceed(t *testing.T) {
 	client := newTestScrapeClient(testStats, []error{nil})
-	scraper, err := serviceScraperForTest(client)
+	scraper, err := serviceScraperForTest(client, MeshCompatibilityModeEnabled)
 	if err != nil {
 		t.Fatalf("serviceScraperForTest=%v, want no error", err)
 	}
@@ -190,7 +203,7 @@ func TestScrapeAllPodsYoungPods(t *testing.T) {
 	testStats := testStatsWithTime(numP, 0. /*youngest*/)
 
 	client := newTestScrapeClient(testStats, []error{nil})
-	scraper, err := serviceScraperForTest(client)
+	scraper, err := serviceScraperForTest(client, MeshCompatibilityModeEnabled)
 	if err != nil {
 		t.Fatalf("serviceScraperForTest=%v, want no error", err)
 	}
@@ -222,7 +235,7 @@ func TestScrapeAllPodsOldPods(t *testing.T) {
 	testStats := testStatsWithTime(numP, youngPodCutOffDuration.Seconds() /*youngest*/)
 
 	client := newTestScrapeClient(testStats, []error{nil})
-	scraper, err := serviceScraperForTest(client)
+	scraper, err := serviceScraperForTest(client, MeshCompatibilityModeEnabled)
 	if err != nil {
 		t.Fatalf("serviceScraperForTest=%v, want no error", err)
 	}
@@ -255,7 +268,7 @@ func TestScrapeSomePodsOldPods(t *testing.T) {
 	testStats := testStatsWithTime(numP, youngPodCutOffDuration.Seconds()/2 /*youngest*/)
 
 	client := newTestScrapeClient(testStats, []error{nil})
-	scraper, err := serviceScraperForTest(client)
+	scraper, err := serviceScraperForTest(client, MeshCompatibilityModeEnabled)
 	if err != nil {
 		t.Fatalf("serviceScraperForTest=%v, want no error", err)
 	}
@@ -283,7 +296,7 @@ func TestScrapeSomePodsOldPods(t *testing.T) {
 
 func TestScrapeReportErrorCannotFindEnoughPods(t *testing.T) {
 	client := newTestScrapeClient(testStats[2:], []error{nil})
-	scraper, err := serviceScraperForTest(client)
+	scraper, err := serviceScraperForTest(client, MeshCompatibilityModeEnabled)
 	if err != nil {
 		t.Fatalf("serviceScraperForTest=%v, want no error", err)
 	}
@@ -303,7 +316,7 @@ func TestScrapeReportErrorIfAnyFails(t *testing.T) {
 	// 1 success and 10 failures so one scrape fails permanently through retries.
 	client := newTestScrapeClient(testStats, []error{nil,
 		errTest, errTest, errTest, errTest, errTest, errTest, errTest, errTest, errTest, errTest})
-	scraper, err := serviceScraperForTest(client)
+	scraper, err := serviceScraperForTest(client, MeshCompatibilityModeEnabled)
 	if err != nil {
 		t.Fatalf("serviceScraperForTest=%v, want no error", err)
 	}
@@ -319,7 +332,7 @@ func TestScrapeReportErrorIfAnyFails(t *testing.T) {
 
 func TestScrapeDoNotScrapeIfNoPodsFound(t *testing.T) {
 	client := newTestScrapeClient(testStats, nil)
-	scraper, err := serviceScraperForTest(client)
+	scraper, err := serviceScraperForTest(client, MeshCompatibilityModeEnabled)
 	if err != nil {
 		t.Fatalf("serviceScraperForTest=%v, want no error", err)
 	}
@@ -336,10 +349,119 @@ func TestScrapeDoNotScrapeIfNoPodsFound(t *testing.T) {
 	}
 }
 
-func serviceScraperForTest(sClient scrapeClient) (*ServiceScraper, error) {
+func TestScrapePodsDirectly(t *testing.T) {
+	client := &fakeScrapeClient{stats: testStats, errs: []error{nil}}
+	scraper, err := serviceScraperForTest(client, MeshCompatibilityModeAuto)
+	if err != nil {
+		t.Fatalf("serviceScraperForTest=%v, want no error", err)
+	}
+
+	// Make an Endpoints with 3 pods.
+	fake.Endpoints(3, fake.TestService)
+
+	got, err := scraper.Scrape(defaultMetric.Spec.StableWindow)
+	if err != nil {
+		t.Fatalf("Unexpected error from scraper.Scrape(): %v", err)
+	}
+
+	// (3.0 + 5.0 + 3.0) / 3.0 * 3 = 11
+	if got.AverageConcurrentRequests != 11.0 {
+		t.Errorf("stat.AverageConcurrentRequests=%v, want %v",
+			got.AverageConcurrentRequests, 11.0)
+	}
+	// All the scrapes must have gone to the pods, not the service.
+	for _, url := range client.urls {
+		if url == testURL {
+			t.Errorf("Scraped %s, want a direct pod scrape", url)
+		}
+	}
+}
+
+func TestScrapeFallsBackToService(t *testing.T) {
+	client := &meshErrorScrapeClient{inner: newTestScrapeClient(testStats, []error{nil})}
+	scraper, err := serviceScraperForTest(client, MeshCompatibilityModeAuto)
+	if err != nil {
+		t.Fatalf("serviceScraperForTest=%v, want no error", err)
+	}
+
+	// Make an Endpoints with 3 pods.
+	fake.Endpoints(3, fake.TestService)
+
+	// The direct scrapes all fail, so the very same scrape must already be
+	// served through the service.
+	got, err := scraper.Scrape(defaultMetric.Spec.StableWindow)
+	if err != nil {
+		t.Fatalf("Unexpected error from scraper.Scrape(): %v", err)
+	}
+	// (3.0 + 5.0 + 3.0) / 3.0 * 3 = 11
+	if got.AverageConcurrentRequests != 11.0 {
+		t.Errorf("stat.AverageConcurrentRequests=%v, want %v",
+			got.AverageConcurrentRequests, 11.0)
+	}
+	if scraper.podsAddressable {
+		t.Error("podsAddressable = true, want false after the fallback")
+	}
+}
+
+func TestScrapeMeshDisabledDoesNotFallBack(t *testing.T) {
+	client := &meshErrorScrapeClient{inner: newTestScrapeClient(testStats, []error{nil})}
+	scraper, err := serviceScraperForTest(client, MeshCompatibilityModeDisabled)
+	if err != nil {
+		t.Fatalf("serviceScraperForTest=%v, want no error", err)
+	}
+
+	// Make an Endpoints with 3 pods.
+	fake.Endpoints(3, fake.TestService)
+
+	if _, err := scraper.Scrape(defaultMetric.Spec.StableWindow); !errors.Is(err, errDirectScrapingNotAvailable) {
+		t.Errorf("scraper.Scrape() = %v, want %v", err, errDirectScrapingNotAvailable)
+	}
+	if !scraper.podsAddressable {
+		t.Error("podsAddressable = false, want true in disabled mode")
+	}
+}
+
+func TestParseMeshCompatibilityMode(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    MeshCompatibilityMode
+		wantErr bool
+	}{{
+		input: "",
+		want:  MeshCompatibilityModeAuto,
+	}, {
+		input: "auto",
+		want:  MeshCompatibilityModeAuto,
+	}, {
+		input: "enabled",
+		want:  MeshCompatibilityModeEnabled,
+	}, {
+		input: "disabled",
+		want:  MeshCompatibilityModeDisabled,
+	}, {
+		input:   "not-a-mode",
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			got, err := ParseMeshCompatibilityMode(test.input)
+			if gotErr := err != nil; gotErr != test.wantErr {
+				t.Errorf("ParseMeshCompatibilityMode() error = %v, wantErr: %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("ParseMeshCompatibilityMode() = %q, want: %q", got, test.want)
+			}
+		})
+	}
+}
+
+func serviceScraperForTest(sClient scrapeClient, meshMode MeshCompatibilityMode) (*ServiceScraper, error) {
 	metric := testMetric()
-	counter := resources.NewScopedEndpointsCounter(fake.KubeInformer.Core().V1().Endpoints().Lister(), fake.TestNamespace, fake.TestService)
-	return newServiceScraperWithClient(metric, counter, sClient)
+	lister := fake.KubeInformer.Core().V1().Endpoints().Lister()
+	counter := resources.NewScopedEndpointsCounter(lister, fake.TestNamespace, fake.TestService)
+	addrs := resources.NewScopedEndpointsAddressGetter(lister, fake.TestNamespace, fake.TestService)
+	return newServiceScraperWithClient(metric, counter, addrs, meshMode, sClient)
 }
 
 func testMetric() *av1alpha1.Metric {
@@ -368,6 +490,7 @@ type fakeScrapeClient struct {
 	i     int
 	stats []Stat
 	errs  []error
+	urls  []string
 	mutex sync.Mutex
 }
 
@@ -378,9 +501,23 @@ func (c *fakeScrapeClient) Scrape(url string) (Stat, error) {
 	ans := c.stats[c.i%len(c.stats)]
 	err := c.errs[c.i%len(c.errs)]
 	c.i++
+	c.urls = append(c.urls, url)
 	return ans, err
 }
 
+// meshErrorScrapeClient fails every scrape that does not go through the
+// service URL, mimicking a mesh enforcing strict mTLS between pods.
+type meshErrorScrapeClient struct {
+	inner scrapeClient
+}
+
+func (c *meshErrorScrapeClient) Scrape(url string) (Stat, error) {
+	if url != testURL {
+		return emptyStat, errors.New("connection reset by mesh")
+	}
+	return c.inner.Scrape(url)
+}
+
 func TestURLFromTarget(t *testing.T) {
 	if got, want := "http://dance.now:9090/metrics", urlFromTarget("dance", "now"); got != want {
 		t.Errorf("urlFromTarget = %s, want: %s, diff: %s", got, want, cmp.Diff(got, want))
@@ -28,6 +28,7 @@ import (
 	"knative.dev/pkg/logging"
 	pkgmetrics "knative.dev/pkg/metrics"
 	"knative.dev/serving/pkg/apis/autoscaling"
+	"knative.dev/serving/pkg/autoscaler/aggregation"
 	"knative.dev/serving/pkg/autoscaler/metrics"
 	"knative.dev/serving/pkg/resources"
 
@@ -50,10 +51,15 @@ type Autoscaler struct {
 	panicTime    time.Time
 	maxPanicPods int32
 
-	// specMux guards the current DeciderSpec and the PodCounter.
+	// specMux guards the current DeciderSpec, the PodCounter and the
+	// delay window.
 	specMux     sync.RWMutex
 	deciderSpec *DeciderSpec
 	podCounter  resources.EndpointsCounter
+	// delayWindow holds the recent scale proposals, so scale-down
+	// decisions can be held back for the configured ScaleDownDelay.
+	// nil if no delay is configured.
+	delayWindow *aggregation.MaxTimeWindow
 }
 
 // New creates a new instance of autoscaler
@@ -94,6 +100,11 @@ func New(
 		pkgmetrics.Record(reporterCtx, panicM.M(0))
 	}
 
+	var delayWindow *aggregation.MaxTimeWindow
+	if deciderSpec.ScaleDownDelay > 0 {
+		delayWindow = aggregation.NewMaxTimeWindow(deciderSpec.ScaleDownDelay, deciderSpec.TickInterval)
+	}
+
 	return &Autoscaler{
 		namespace:    namespace,
 		revision:     revision,
@@ -103,6 +114,7 @@ func New(
 
 		deciderSpec: deciderSpec,
 		podCounter:  podCounter,
+		delayWindow: delayWindow,
 
 		panicTime:    pt,
 		maxPanicPods: int32(curC),
@@ -119,17 +131,32 @@ func (a *Autoscaler) Update(deciderSpec *DeciderSpec) error {
 		a.podCounter = resources.NewScopedEndpointsCounter(a.lister, a.namespace,
 			deciderSpec.ServiceName)
 	}
+	// Rebuild the delay window if the delay changed. The accumulated
+	// history is lost, but that's tolerable for a settings change.
+	if deciderSpec.ScaleDownDelay != a.deciderSpec.ScaleDownDelay {
+		a.delayWindow = nil
+		if deciderSpec.ScaleDownDelay > 0 {
+			a.delayWindow = aggregation.NewMaxTimeWindow(deciderSpec.ScaleDownDelay, deciderSpec.TickInterval)
+		}
+	}
 	a.deciderSpec = deciderSpec
 	return nil
 }
 
+// Panicking returns whether the autoscaler is currently in panic mode.
+func (a *Autoscaler) Panicking() bool {
+	a.stateMux.Lock()
+	defer a.stateMux.Unlock()
+	return !a.panicTime.IsZero()
+}
+
 // Scale calculates the desired scale based on current statistics given the current time.
 // desiredPodCount is the calculated pod count the autoscaler would like to set.
 // validScale signifies whether the desiredPodCount should be applied or not.
 func (a *Autoscaler) Scale(ctx context.Context, now time.Time) (desiredPodCount int32, excessBC int32, validScale bool) {
 	logger := logging.FromContext(ctx)
 
-	spec, podCounter := a.currentSpecAndPC()
+	spec, podCounter, delayWindow := a.currentSpecAndPC()
 	originalReadyPodsCount, err := podCounter.ReadyCount()
 	// If the error is NotFound, then presume 0.
 	if err != nil && !apierrors.IsNotFound(err) {
@@ -223,6 +250,26 @@ func (a *Autoscaler) Scale(ctx context.Context, now time.Time) (desiredPodCount
 		desiredPodCount = desiredStablePodCount
 	}
 
+	// If an activation scale was specified, maintain at least that many
+	// pods whenever the revision is not supposed to be scaled to zero.
+	// In particular this makes scale-from-zero jump straight to the
+	// activation scale rather than to one pod.
+	if as := spec.ActivationScale; as > 1 && dspc > 0 && as > desiredPodCount {
+		desiredPodCount = as
+	}
+
+	// Delay the scale down, if a ScaleDownDelay was specified. We only
+	// reduce the pod count when the lower proposal has been sustained
+	// for the whole delay window; scale ups always pass through.
+	if delayWindow != nil {
+		delayWindow.Record(now, desiredPodCount)
+		delayedPodCount := delayWindow.Current()
+		if delayedPodCount != desiredPodCount {
+			logger.Debugf("Delaying scale to %d, staying at %d", desiredPodCount, delayedPodCount)
+			desiredPodCount = delayedPodCount
+		}
+	}
+
 	// Compute the excess burst capacity based on stable value for now, since we don't want to
 	// be making knee-jerk decisions about Activator in the request path. Negative EBC means
 	// that the deployment does not have enough capacity to serve the desired burst off hand.
@@ -245,8 +292,8 @@ func (a *Autoscaler) Scale(ctx context.Context, now time.Time) (desiredPodCount
 	return desiredPodCount, int32(excessBCF), true
 }
 
-func (a *Autoscaler) currentSpecAndPC() (*DeciderSpec, resources.EndpointsCounter) {
+func (a *Autoscaler) currentSpecAndPC() (*DeciderSpec, resources.EndpointsCounter, *aggregation.MaxTimeWindow) {
 	a.specMux.RLock()
 	defer a.specMux.RUnlock()
-	return a.deciderSpec, a.podCounter
+	return a.deciderSpec, a.podCounter, a.delayWindow
 }
@@ -73,7 +73,7 @@ func TestAutoscalerMetrics(t *testing.T) {
 	a := newTestAutoscaler(t, 10, 100, metricClient)
 	ebc := expectedEBC(10, 100, 50, 1)
 	a.expectScale(t, time.Now(), 5, ebc, true)
-	spec, _ := a.currentSpecAndPC()
+	spec, _, _ := a.currentSpecAndPC()
 	wantTags := map[string]string{
 		metricskey.LabelConfigurationName: fake.TestConfig,
 		metricskey.LabelNamespaceName:     fake.TestNamespace,
@@ -94,7 +94,7 @@ func TestAutoscalerMetricsWithRPS(t *testing.T) {
 	a := newTestAutoscalerWithScalingMetric(t, 10, 100, metricClient, "rps")
 	ebc := expectedEBC(10, 100, 100, 1)
 	a.expectScale(t, time.Now(), 10, ebc, true)
-	spec, _ := a.currentSpecAndPC()
+	spec, _, _ := a.currentSpecAndPC()
 	wantTags := map[string]string{
 		metricskey.LabelConfigurationName: fake.TestConfig,
 		metricskey.LabelNamespaceName:     fake.TestNamespace,
@@ -155,6 +155,56 @@ func TestAutoscalerStableModeDecrease(t *testing.T) {
 	a.expectScale(t, time.Now(), 5, expectedEBC(10, 98, 50, 8), true)
 }
 
+func TestAutoscalerActivationScale(t *testing.T) {
+	metrics := &autoscalerfake.MetricClient{StableConcurrency: 1}
+	a := newTestAutoscaler(t, 10, 75, metrics)
+
+	spec := *a.deciderSpec
+	spec.ActivationScale = 3
+	a.Update(&spec)
+
+	// With traffic, the activation scale wins over the computed 1 pod.
+	a.expectScale(t, time.Now(), 3, expectedEBC(10, 75, 1, 1), true)
+
+	// Enough traffic for more than the activation scale just scales normally.
+	metrics.StableConcurrency = 50
+	a.expectScale(t, time.Now(), 5, expectedEBC(10, 75, 50, 1), true)
+
+	// No traffic still scales to zero.
+	metrics.StableConcurrency = 0
+	a.expectScale(t, time.Now(), 0, expectedEBC(10, 75, 0, 1), true)
+}
+
+func TestAutoscalerScaleDownDelay(t *testing.T) {
+	metrics := &autoscalerfake.MetricClient{StableConcurrency: 100.0}
+	a := newTestAutoscaler(t, 10, 98, metrics)
+
+	spec := *a.deciderSpec
+	spec.TickInterval = 2 * time.Second
+	spec.ScaleDownDelay = 10 * time.Second
+	a.Update(&spec)
+
+	now := time.Now()
+	fake.Endpoints(8, fake.TestService)
+	a.expectScale(t, now, 10, expectedEBC(10, 98, 100, 8), true)
+
+	// The proposal drops, but the delay window keeps the scale at 10.
+	metrics.StableConcurrency = 50
+	a.expectScale(t, now.Add(2*time.Second), 10, expectedEBC(10, 98, 50, 8), true)
+
+	// Scale ups always pass through immediately.
+	metrics.StableConcurrency = 120
+	a.expectScale(t, now.Add(4*time.Second), 12, expectedEBC(10, 98, 120, 8), true)
+
+	// Once the lower proposal has been sustained for the whole delay
+	// window, the scale down is applied.
+	metrics.StableConcurrency = 50
+	for i := 6; i <= 14; i += 2 {
+		a.Scale(TestContextWithLogger(t), now.Add(time.Duration(i)*time.Second))
+	}
+	a.expectScale(t, now.Add(16*time.Second), 5, expectedEBC(10, 98, 50, 8), true)
+}
+
 func TestAutoscalerStableModeNoTrafficScaleToZero(t *testing.T) {
 	metrics := &autoscalerfake.MetricClient{StableConcurrency: 1}
 	a := newTestAutoscaler(t, 10, 75, metrics)
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	"knative.dev/serving/pkg/autoscaler/metrics"
+)
+
+// deciderDebug is the JSON document served for a single revision's decider.
+type deciderDebug struct {
+	ScalingMetric       string  `json:"scalingMetric"`
+	TargetValue         float64 `json:"targetValue"`
+	PanicThreshold      float64 `json:"panicThreshold"`
+	StableWindow        string  `json:"stableWindow"`
+	Panicking           bool    `json:"panicking"`
+	ObservedStableValue float64 `json:"observedStableValue"`
+	ObservedPanicValue  float64 `json:"observedPanicValue"`
+	DesiredScale        int32   `json:"desiredScale"`
+	ExcessBurstCapacity int32   `json:"excessBurstCapacity"`
+}
+
+// panicker is implemented by scalers that track panic mode.
+type panicker interface {
+	Panicking() bool
+}
+
+// NewDebugHandler returns an http.Handler serving a JSON snapshot of every
+// decider the MultiScaler is running, keyed by namespace/name, so operators
+// can see why a revision is at its current scale.
+func NewDebugHandler(ms *MultiScaler, mc metrics.MetricClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(ms.debugState(mc, time.Now())); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+func (m *MultiScaler) debugState(mc metrics.MetricClient, now time.Time) map[string]deciderDebug {
+	m.scalersMutex.RLock()
+	defer m.scalersMutex.RUnlock()
+	state := make(map[string]deciderDebug, len(m.scalers))
+	for key, runner := range m.scalers {
+		runner.mux.RLock()
+		decider := runner.decider.DeepCopy()
+		runner.mux.RUnlock()
+
+		debug := deciderDebug{
+			ScalingMetric:       decider.Spec.ScalingMetric,
+			TargetValue:         decider.Spec.TargetValue,
+			PanicThreshold:      decider.Spec.PanicThreshold,
+			StableWindow:        decider.Spec.StableWindow.String(),
+			DesiredScale:        decider.Status.DesiredScale,
+			ExcessBurstCapacity: decider.Status.ExcessBurstCapacity,
+		}
+		if p, ok := runner.scaler.(panicker); ok {
+			debug.Panicking = p.Panicking()
+		}
+		debug.ObservedStableValue, debug.ObservedPanicValue = observedValues(
+			mc, decider.Spec.ScalingMetric, key, now)
+
+		state[key.String()] = debug
+	}
+	return state
+}
+
+// observedValues returns the decider's observed stable and panic values,
+// or zeroes when no metrics have been collected for the revision yet.
+func observedValues(mc metrics.MetricClient, metric string, key types.NamespacedName, now time.Time) (float64, float64) {
+	var stable, panic float64
+	var err error
+	if metric == autoscaling.RPS {
+		stable, panic, err = mc.StableAndPanicRPS(key, now)
+	} else {
+		stable, panic, err = mc.StableAndPanicConcurrency(key, now)
+	}
+	if err != nil {
+		return 0, 0
+	}
+	return stable, panic
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "knative.dev/pkg/logging/testing"
+	"knative.dev/serving/pkg/autoscaler/fake"
+)
+
+func TestDebugHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ms, uniScaler := createMultiScaler(ctx, TestLogger(t))
+	uniScaler.setScaleResult(1, 1, true)
+
+	decider := newDecider()
+	if _, err := ms.Create(ctx, decider); err != nil {
+		t.Fatalf("Create() = %v", err)
+	}
+
+	handler := NewDebugHandler(ms, &fake.StaticMetricClient)
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/debug/deciders", nil))
+
+	if got, want := resp.Code, http.StatusOK; got != want {
+		t.Fatalf("StatusCode = %d, want %d", got, want)
+	}
+
+	var state map[string]deciderDebug
+	if err := json.NewDecoder(resp.Body).Decode(&state); err != nil {
+		t.Fatalf("Decode() = %v", err)
+	}
+
+	key := fake.TestNamespace + "/" + fake.TestRevision
+	debug, ok := state[key]
+	if !ok {
+		t.Fatalf("Debug state is missing key %q, got: %v", key, state)
+	}
+	if got, want := debug.TargetValue, 1.0; got != want {
+		t.Errorf("TargetValue = %v, want %v", got, want)
+	}
+	if got, want := debug.DesiredScale, int32(-1); got != want {
+		t.Errorf("DesiredScale = %d, want %d", got, want)
+	}
+	// The static metric client reports a concurrency of 10.
+	if got, want := debug.ObservedStableValue, 10.0; got != want {
+		t.Errorf("ObservedStableValue = %v, want %v", got, want)
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/logging/logkey"
+	"knative.dev/serving/pkg/apis/autoscaling"
 	av1alpha1 "knative.dev/serving/pkg/apis/autoscaling/v1alpha1"
 	"knative.dev/serving/pkg/autoscaler/metrics"
 )
@@ -59,6 +60,12 @@ type DeciderSpec struct {
 	PanicThreshold      float64
 	// StableWindow is needed to determine when to exit panicmode.
 	StableWindow time.Duration
+	// ScaleDownDelay is the time that must pass at reduced desired scale
+	// before the scale-down is actually applied.
+	ScaleDownDelay time.Duration
+	// ActivationScale is the minimum scale to jump to when scaling from
+	// zero. Values below 2 have no effect.
+	ActivationScale int32
 	// The name of the k8s service for pod information.
 	ServiceName string
 }
@@ -108,6 +115,13 @@ func (sr *scalerRunner) latestScale() int32 {
 	return sr.decider.Status.DesiredScale
 }
 
+// scalingMetric returns the metric the runner's decider is scaling on.
+func (sr *scalerRunner) scalingMetric() string {
+	sr.mux.RLock()
+	defer sr.mux.RUnlock()
+	return sr.decider.Spec.ScalingMetric
+}
+
 func sameSign(a, b int32) bool {
 	return (a&math.MinInt32)^(b&math.MinInt32) == 0
 }
@@ -336,7 +350,17 @@ func (m *MultiScaler) Poke(key types.NamespacedName, stat metrics.Stat) {
 		return
 	}
 
-	if scaler.latestScale() == 0 && stat.AverageConcurrentRequests != 0 {
+	if scaler.latestScale() != 0 {
+		return
+	}
+
+	// Pick the signal that matches the decider's scaling metric, so that
+	// RPS-scaled revisions wake up from zero just like concurrency-scaled ones.
+	haveTraffic := stat.AverageConcurrentRequests != 0
+	if scaler.scalingMetric() == autoscaling.RPS {
+		haveTraffic = stat.RequestCount != 0
+	}
+	if haveTraffic {
 		scaler.pokeCh <- struct{}{}
 	}
 }
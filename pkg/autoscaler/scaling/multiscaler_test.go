@@ -31,6 +31,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	. "knative.dev/pkg/logging/testing"
+	"knative.dev/serving/pkg/apis/autoscaling"
 	"knative.dev/serving/pkg/autoscaler/fake"
 	"knative.dev/serving/pkg/autoscaler/metrics"
 )
@@ -393,6 +394,52 @@ func TestMultiScalerScaleFromZero(t *testing.T) {
 	}
 }
 
+func TestMultiScalerScaleFromZeroRPS(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ms, uniScaler := createMultiScaler(ctx, TestLogger(t))
+	mtp := &fake.ManualTickProvider{
+		Channel: make(chan time.Time, 1),
+	}
+	ms.tickProvider = mtp.NewTicker
+
+	decider := newDecider()
+	decider.Spec.TickInterval = 60 * time.Second
+	decider.Spec.ScalingMetric = autoscaling.RPS
+	uniScaler.setScaleResult(1, 1, true)
+
+	errCh := make(chan error)
+	ms.Watch(watchFunc(ctx, ms, decider, 1, errCh))
+
+	_, err := ms.Create(ctx, decider)
+	if err != nil {
+		t.Fatalf("Create() = %v", err)
+	}
+	metricKey := types.NamespacedName{Namespace: decider.Namespace, Name: decider.Name}
+	if scaler, exists := ms.scalers[metricKey]; !exists {
+		t.Errorf("Failed to get scaler for metric %s", metricKey)
+	} else if !scaler.updateLatestScale(0, 10) {
+		t.Error("Failed to set scale for metric to 0")
+	}
+
+	// No concurrency data at all, but requests were seen — the RPS
+	// scaled decider must still be poked.
+	testStat := metrics.Stat{
+		Time:         time.Now(),
+		PodName:      "test-pod",
+		RequestCount: 1,
+	}
+	ms.Poke(metricKey, testStat)
+
+	// Verify that we see a "tick", even without ticking the channel
+	if err := verifyTick(errCh); err != nil {
+		t.Fatal(err)
+	}
+	if err := ms.Delete(ctx, decider.Namespace, decider.Name); err != nil {
+		t.Errorf("Delete() = %v", err)
+	}
+}
+
 func TestMultiScalerIgnoreNegativeScale(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -0,0 +1,234 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package statforwarder assigns Autoscaler buckets to replicas via
+// coordination.k8s.io Leases and routes stat messages to the replica
+// owning the respective bucket.
+package statforwarder
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	pkgnet "knative.dev/pkg/network"
+	"knative.dev/pkg/system"
+	"knative.dev/pkg/websocket"
+	"knative.dev/serving/pkg/autoscaler/bucket"
+	asmetrics "knative.dev/serving/pkg/autoscaler/metrics"
+	"knative.dev/serving/pkg/leaderelection"
+)
+
+// The port on which the Autoscaler stat WebSocket server listens.
+const autoscalerPort = 8080
+
+// statProcessor is a function to process a single StatMessage.
+type statProcessor func(sm asmetrics.StatMessage)
+
+// bucketProcessor handles stats of a single bucket.
+type bucketProcessor struct {
+	// holder is the IP of the pod owning the bucket.
+	holder string
+	// conn is the WebSocket connection to the owning pod. It is nil if
+	// this pod owns the bucket, in which case stats are accepted locally.
+	conn *websocket.ManagedConnection
+}
+
+// Forwarder tracks bucket ownership and either accepts stats locally or
+// forwards them to the owning autoscaler replica.
+type Forwarder struct {
+	selfIP string
+	logger *zap.SugaredLogger
+	kc     kubernetes.Interface
+	bs     *bucket.BucketSet
+
+	// accept is called to process stats owned by this pod.
+	accept statProcessor
+
+	// elector maintains the bucket Leases and tracks their holders.
+	elector *leaderelection.Elector
+
+	processorsLock sync.RWMutex
+	processors     map[string]*bucketProcessor
+}
+
+// New creates a Forwarder and starts the leader election loop
+// maintaining the bucket Leases and their processors.
+func New(ctx context.Context, logger *zap.SugaredLogger, kc kubernetes.Interface,
+	selfIP string, bs *bucket.BucketSet, accept statProcessor) *Forwarder {
+	f := &Forwarder{
+		selfIP:     selfIP,
+		logger:     logger,
+		kc:         kc,
+		bs:         bs,
+		accept:     accept,
+		processors: make(map[string]*bucketProcessor),
+	}
+	f.elector = leaderelection.New(ctx, logger, kc, selfIP, bs, f.bucketChanged)
+	return f
+}
+
+// Elector returns the Elector maintaining the bucket Leases, so callers
+// can gate other work on the same bucket ownership as the stats.
+func (f *Forwarder) Elector() *leaderelection.Elector {
+	return f.elector
+}
+
+// Process delivers the given StatMessage to the replica owning the
+// revision, either via the local accept function or over a WebSocket
+// connection to another replica. Stats arriving before bucket ownership
+// has been established are dropped.
+func (f *Forwarder) Process(sm asmetrics.StatMessage) {
+	bkt := f.bs.Owner(sm.Key.String())
+
+	f.processorsLock.RLock()
+	p := f.processors[bkt]
+	f.processorsLock.RUnlock()
+
+	if p == nil {
+		f.logger.Warnf("Dropping stat for %s: bucket %s has no owner yet", sm.Key, bkt)
+		return
+	}
+	if p.conn != nil {
+		if err := p.conn.Send(sm); err != nil {
+			f.logger.Errorw("Error while forwarding stat", zap.String("bucket", bkt), zap.Error(err))
+		}
+		return
+	}
+	f.accept(sm)
+}
+
+// Cancel shuts down the WebSocket connections to the other replicas.
+func (f *Forwarder) Cancel() {
+	f.processorsLock.Lock()
+	defer f.processorsLock.Unlock()
+	for _, p := range f.processors {
+		if p.conn != nil {
+			p.conn.Shutdown()
+		}
+	}
+	f.processors = make(map[string]*bucketProcessor)
+}
+
+// bucketChanged reacts to a change of the holder of a bucket's Lease.
+func (f *Forwarder) bucketChanged(bkt, holder string) {
+	if holder == f.selfIP {
+		if err := f.reconcileService(bkt); err != nil {
+			f.logger.Errorw("Failed to reconcile Service", zap.String("bucket", bkt), zap.Error(err))
+		}
+		if err := f.reconcileEndpoints(bkt); err != nil {
+			f.logger.Errorw("Failed to reconcile Endpoints", zap.String("bucket", bkt), zap.Error(err))
+		}
+	}
+	f.setProcessor(bkt, holder)
+}
+
+// reconcileService makes sure a Service with the bucket's name exists, so
+// activators and other replicas can reach the owner through a stable DNS
+// name. The Service is selectorless; the Endpoints are maintained by the
+// owner directly.
+func (f *Forwarder) reconcileService(bkt string) error {
+	svcs := f.kc.CoreV1().Services(system.Namespace())
+	_, err := svcs.Get(bkt, metav1.GetOptions{})
+	if apierrs.IsNotFound(err) {
+		_, err = svcs.Create(&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      bkt,
+				Namespace: system.Namespace(),
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{
+					Name:       "http",
+					Port:       autoscalerPort,
+					TargetPort: intstr.FromInt(autoscalerPort),
+				}},
+			},
+		})
+		if apierrs.IsAlreadyExists(err) {
+			err = nil
+		}
+	}
+	return err
+}
+
+// reconcileEndpoints points the bucket's Service at this pod.
+func (f *Forwarder) reconcileEndpoints(bkt string) error {
+	eps := f.kc.CoreV1().Endpoints(system.Namespace())
+	want := []corev1.EndpointSubset{{
+		Addresses: []corev1.EndpointAddress{{IP: f.selfIP}},
+		Ports: []corev1.EndpointPort{{
+			Name: "http",
+			Port: autoscalerPort,
+		}},
+	}}
+
+	e, err := eps.Get(bkt, metav1.GetOptions{})
+	if apierrs.IsNotFound(err) {
+		_, err = eps.Create(&corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      bkt,
+				Namespace: system.Namespace(),
+			},
+			Subsets: want,
+		})
+		if apierrs.IsAlreadyExists(err) {
+			err = nil
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(e.Subsets, want) {
+		return nil
+	}
+	e = e.DeepCopy()
+	e.Subsets = want
+	_, err = eps.Update(e)
+	return err
+}
+
+func (f *Forwarder) setProcessor(bkt, holder string) {
+	f.processorsLock.Lock()
+	defer f.processorsLock.Unlock()
+
+	existing := f.processors[bkt]
+	if existing != nil && existing.holder == holder {
+		return
+	}
+	if existing != nil && existing.conn != nil {
+		existing.conn.Shutdown()
+	}
+	if holder == "" {
+		delete(f.processors, bkt)
+		return
+	}
+
+	p := &bucketProcessor{holder: holder}
+	if holder != f.selfIP {
+		dns := fmt.Sprintf("ws://%s.%s.svc.%s:%d", bkt, system.Namespace(), pkgnet.GetClusterDomainName(), autoscalerPort)
+		f.logger.Info("Connecting to Autoscaler bucket at ", dns)
+		p.conn = websocket.NewDurableSendingConnection(dns, f.logger)
+	}
+	f.processors[bkt] = p
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statforwarder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+	"knative.dev/pkg/system"
+	"knative.dev/serving/pkg/autoscaler/bucket"
+	asmetrics "knative.dev/serving/pkg/autoscaler/metrics"
+
+	. "knative.dev/pkg/logging/testing"
+	_ "knative.dev/pkg/system/testing"
+)
+
+const selfIP = "1.2.3.4"
+
+func TestForwarderOwnsBucket(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kc := fake.NewSimpleClientset()
+	acceptCh := make(chan asmetrics.StatMessage, 1)
+	f := New(ctx, TestLogger(t), kc, selfIP, bucket.AutoscalerBucketSet(1),
+		func(sm asmetrics.StatMessage) { acceptCh <- sm })
+	defer f.Cancel()
+
+	bkt := bucket.AutoscalerBucketName(0, 1)
+	if err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		_, err := kc.CoreV1().Endpoints(system.Namespace()).Get(bkt, metav1.GetOptions{})
+		if apierrs.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	}); err != nil {
+		t.Fatal("Timed out waiting for the bucket Endpoints:", err)
+	}
+
+	l, err := kc.CoordinationV1().Leases(system.Namespace()).Get(bkt, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal("Lease not created:", err)
+	}
+	if got := *l.Spec.HolderIdentity; got != selfIP {
+		t.Errorf("Lease holder = %q, want: %q", got, selfIP)
+	}
+	if _, err := kc.CoreV1().Services(system.Namespace()).Get(bkt, metav1.GetOptions{}); err != nil {
+		t.Error("Service not created:", err)
+	}
+	e, err := kc.CoreV1().Endpoints(system.Namespace()).Get(bkt, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal("Endpoints not created:", err)
+	}
+	if got := e.Subsets[0].Addresses[0].IP; got != selfIP {
+		t.Errorf("Endpoints IP = %q, want: %q", got, selfIP)
+	}
+
+	// Stats for the owned bucket are accepted locally.
+	sm := asmetrics.StatMessage{Key: types.NamespacedName{Namespace: "default", Name: "rev"}}
+	f.Process(sm)
+	select {
+	case got := <-acceptCh:
+		if got.Key != sm.Key {
+			t.Errorf("Accepted stat for %v, want: %v", got.Key, sm.Key)
+		}
+	case <-time.After(time.Second):
+		t.Error("Timed out waiting for the stat to be accepted")
+	}
+}
+
+func TestForwarderRespectsHeldLease(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const otherIP = "5.6.7.8"
+	bkt := bucket.AutoscalerBucketName(0, 1)
+	now := metav1.NewMicroTime(time.Now())
+	kc := fake.NewSimpleClientset(&coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bkt,
+			Namespace: system.Namespace(),
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.String(otherIP),
+			LeaseDurationSeconds: ptr.Int32(1000),
+			RenewTime:            &now,
+		},
+	})
+	f := New(ctx, TestLogger(t), kc, selfIP, bucket.AutoscalerBucketSet(1),
+		func(asmetrics.StatMessage) {
+			t.Error("Stat accepted locally, want it forwarded")
+		})
+	defer f.Cancel()
+
+	if err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		f.processorsLock.RLock()
+		p := f.processors[bkt]
+		f.processorsLock.RUnlock()
+		return p != nil, nil
+	}); err != nil {
+		t.Fatal("Timed out waiting for the bucket processor:", err)
+	}
+
+	l, err := kc.CoordinationV1().Leases(system.Namespace()).Get(bkt, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal("Lease not found:", err)
+	}
+	if got := *l.Spec.HolderIdentity; got != otherIP {
+		t.Errorf("Lease holder = %q, want: %q", got, otherIP)
+	}
+	f.processorsLock.RLock()
+	p := f.processors[bkt]
+	f.processorsLock.RUnlock()
+	if p.conn == nil {
+		t.Error("Expected a forwarding processor for the foreign bucket")
+	}
+}
@@ -32,6 +32,10 @@ func (c *FakeServingV1alpha1) Configurations(namespace string) v1alpha1.Configur
 	return &FakeConfigurations{c, namespace}
 }
 
+func (c *FakeServingV1alpha1) DomainMappings(namespace string) v1alpha1.DomainMappingInterface {
+	return &FakeDomainMappings{c, namespace}
+}
+
 func (c *FakeServingV1alpha1) Revisions(namespace string) v1alpha1.RevisionInterface {
 	return &FakeRevisions{c, namespace}
 }
@@ -27,6 +27,7 @@ import (
 type ServingV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	ConfigurationsGetter
+	DomainMappingsGetter
 	RevisionsGetter
 	RoutesGetter
 	ServicesGetter
@@ -41,6 +42,10 @@ func (c *ServingV1alpha1Client) Configurations(namespace string) ConfigurationIn
 	return newConfigurations(c, namespace)
 }
 
+func (c *ServingV1alpha1Client) DomainMappings(namespace string) DomainMappingInterface {
+	return newDomainMappings(c, namespace)
+}
+
 func (c *ServingV1alpha1Client) Revisions(namespace string) RevisionInterface {
 	return newRevisions(c, namespace)
 }
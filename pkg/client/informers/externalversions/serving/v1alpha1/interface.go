@@ -26,6 +26,8 @@ import (
 type Interface interface {
 	// Configurations returns a ConfigurationInformer.
 	Configurations() ConfigurationInformer
+	// DomainMappings returns a DomainMappingInformer.
+	DomainMappings() DomainMappingInformer
 	// Revisions returns a RevisionInformer.
 	Revisions() RevisionInformer
 	// Routes returns a RouteInformer.
@@ -50,6 +52,11 @@ func (v *version) Configurations() ConfigurationInformer {
 	return &configurationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// DomainMappings returns a DomainMappingInformer.
+func (v *version) DomainMappings() DomainMappingInformer {
+	return &domainMappingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Revisions returns a RevisionInformer.
 func (v *version) Revisions() RevisionInformer {
 	return &revisionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
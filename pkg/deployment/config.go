@@ -18,9 +18,13 @@ package deployment
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -31,6 +35,48 @@ const (
 	// QueueSidecarImageKey is the config map key for queue sidecar image
 	QueueSidecarImageKey           = "queueSidecarImage"
 	registriesSkippingTagResolving = "registriesSkippingTagResolving"
+
+	// ConcurrencyStateEndpointKey is the config map key for the endpoint
+	// queue-proxy calls when the pod transitions between zero and non-zero
+	// in-flight requests.
+	ConcurrencyStateEndpointKey = "concurrencyStateEndpoint"
+
+	// ProgressDeadlineKey is the config map key for the progress deadline of
+	// revision deployments.
+	ProgressDeadlineKey = "progressDeadline"
+
+	// QueueSidecarDrainTimeoutKey is the config map key for the time the
+	// queue proxy keeps serving after receiving SIGTERM before it exits.
+	QueueSidecarDrainTimeoutKey = "queueSidecarDrainTimeout"
+
+	// PodLabelsKey is the config map key for extra labels added to the pods
+	// of every revision deployment.
+	PodLabelsKey = "podLabels"
+
+	// PodAnnotationsKey is the config map key for extra annotations added to
+	// the pods of every revision deployment.
+	PodAnnotationsKey = "podAnnotations"
+
+	// QueueSidecarCPURequestKey is the config map key for the queue proxy's
+	// CPU request.
+	QueueSidecarCPURequestKey = "queueSidecarCPURequest"
+
+	// QueueSidecarCPULimitKey is the config map key for the queue proxy's
+	// CPU limit.
+	QueueSidecarCPULimitKey = "queueSidecarCPULimit"
+
+	// QueueSidecarMemoryRequestKey is the config map key for the queue
+	// proxy's memory request.
+	QueueSidecarMemoryRequestKey = "queueSidecarMemoryRequest"
+
+	// QueueSidecarMemoryLimitKey is the config map key for the queue proxy's
+	// memory limit.
+	QueueSidecarMemoryLimitKey = "queueSidecarMemoryLimit"
+
+	// QueueSidecarResourcePercentageKey is the config map key for the
+	// percentage of the user container's resources used to size the queue
+	// proxy sidecar.
+	QueueSidecarResourcePercentageKey = "queueSidecarResourcePercentage"
 )
 
 // NewConfigFromMap creates a DeploymentConfig from the supplied Map
@@ -48,9 +94,102 @@ func NewConfigFromMap(configMap map[string]string) (*Config, error) {
 	} else {
 		nc.RegistriesSkippingTagResolving = sets.NewString(strings.Split(registries, ",")...)
 	}
+
+	// It is ok if the concurrency state endpoint is missing.
+	nc.ConcurrencyStateEndpoint = configMap[ConcurrencyStateEndpointKey]
+
+	// It is ok if the progress deadline is missing; the deployment builder
+	// falls back to its built-in default.
+	if pd, ok := configMap[ProgressDeadlineKey]; ok {
+		d, err := time.ParseDuration(pd)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", ProgressDeadlineKey, err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("%s cannot be non-positive, was: %v", ProgressDeadlineKey, d)
+		}
+		nc.ProgressDeadline = d
+	}
+
+	// It is ok if the drain timeout is missing; the queue proxy falls back
+	// to its built-in default.
+	if dt, ok := configMap[QueueSidecarDrainTimeoutKey]; ok {
+		d, err := time.ParseDuration(dt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", QueueSidecarDrainTimeoutKey, err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("%s cannot be non-positive, was: %v", QueueSidecarDrainTimeoutKey, d)
+		}
+		nc.QueueSidecarDrainTimeout = d
+	}
+
+	if labels, ok := configMap[PodLabelsKey]; ok {
+		parsed, err := parseKeyValuePairs(labels)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", PodLabelsKey, err)
+		}
+		nc.PodLabels = parsed
+	}
+	if annotations, ok := configMap[PodAnnotationsKey]; ok {
+		parsed, err := parseKeyValuePairs(annotations)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", PodAnnotationsKey, err)
+		}
+		nc.PodAnnotations = parsed
+	}
+
+	for _, q := range []struct {
+		key    string
+		target **resource.Quantity
+	}{{
+		key:    QueueSidecarCPURequestKey,
+		target: &nc.QueueSidecarCPURequest,
+	}, {
+		key:    QueueSidecarCPULimitKey,
+		target: &nc.QueueSidecarCPULimit,
+	}, {
+		key:    QueueSidecarMemoryRequestKey,
+		target: &nc.QueueSidecarMemoryRequest,
+	}, {
+		key:    QueueSidecarMemoryLimitKey,
+		target: &nc.QueueSidecarMemoryLimit,
+	}} {
+		if v, ok := configMap[q.key]; ok {
+			quantity, err := resource.ParseQuantity(v)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", q.key, err)
+			}
+			*q.target = &quantity
+		}
+	}
+
+	if v, ok := configMap[QueueSidecarResourcePercentageKey]; ok {
+		percentage, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", QueueSidecarResourcePercentageKey, err)
+		}
+		if percentage <= 0 || percentage > 100 {
+			return nil, fmt.Errorf("%s must be in (0, 100], was: %v", QueueSidecarResourcePercentageKey, percentage)
+		}
+		nc.QueueSidecarResourcePercentage = percentage
+	}
 	return nc, nil
 }
 
+// parseKeyValuePairs parses a comma separated list of key=value pairs into a map.
+func parseKeyValuePairs(value string) (map[string]string, error) {
+	kv := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("expected comma separated key=value pairs, got %q", pair)
+		}
+		kv[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return kv, nil
+}
+
 // NewConfigFromConfigMap creates a DeploymentConfig from the supplied configMap
 func NewConfigFromConfigMap(config *corev1.ConfigMap) (*Config, error) {
 	return NewConfigFromMap(config.Data)
@@ -64,4 +203,53 @@ type Config struct {
 
 	// Repositories for which tag to digest resolving should be skipped
 	RegistriesSkippingTagResolving sets.String
+
+	// ConcurrencyStateEndpoint is the endpoint queue-proxy calls when the
+	// pod transitions between zero and non-zero in-flight requests.  Empty
+	// disables the feature.
+	ConcurrencyStateEndpoint string
+
+	// ProgressDeadline is the time to wait for the revision deployment to
+	// make progress before the revision is considered failed.  Zero means
+	// the deployment builder's built-in default is used.
+	ProgressDeadline time.Duration
+
+	// QueueSidecarDrainTimeout is the time the queue proxy keeps serving
+	// after receiving SIGTERM before it exits.  Zero means the queue
+	// proxy's built-in default is used.
+	QueueSidecarDrainTimeout time.Duration
+
+	// PodLabels are extra labels added to the pods of every revision
+	// deployment.  Labels the Revision itself sets take precedence.
+	PodLabels map[string]string
+
+	// PodAnnotations are extra annotations added to the pods of every
+	// revision deployment.  Annotations the Revision itself sets take
+	// precedence.
+	PodAnnotations map[string]string
+
+	// QueueSidecarCPURequest is the CPU request of the queue proxy sidecar.
+	// Nil means the default computed by the deployment builder is used.
+	QueueSidecarCPURequest *resource.Quantity
+
+	// QueueSidecarCPULimit is the CPU limit of the queue proxy sidecar.
+	// Nil means no limit is applied, unless computed from the resource
+	// percentage.
+	QueueSidecarCPULimit *resource.Quantity
+
+	// QueueSidecarMemoryRequest is the memory request of the queue proxy
+	// sidecar.  Nil means no request is applied, unless computed from the
+	// resource percentage.
+	QueueSidecarMemoryRequest *resource.Quantity
+
+	// QueueSidecarMemoryLimit is the memory limit of the queue proxy
+	// sidecar.  Nil means no limit is applied, unless computed from the
+	// resource percentage.
+	QueueSidecarMemoryLimit *resource.Quantity
+
+	// QueueSidecarResourcePercentage is the percentage of the user
+	// container's resource requests and limits used to size the queue proxy
+	// sidecar, for Revisions that don't carry the resource percentage
+	// annotation.  Zero disables the percentage mode.
+	QueueSidecarResourcePercentage float64
 }
@@ -18,9 +18,11 @@ package deployment
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/system"
@@ -31,6 +33,11 @@ import (
 
 var noSidecarImage = ""
 
+func quantityPtr(s string) *resource.Quantity {
+	q := resource.MustParse(s)
+	return &q
+}
+
 func TestControllerConfigurationFromFile(t *testing.T) {
 	cm, example := ConfigMapsFromTestFile(t, ConfigName, QueueSidecarImageKey)
 
@@ -82,6 +89,195 @@ func TestControllerConfiguration(t *testing.T) {
 				registriesSkippingTagResolving: "ko.local,ko.dev",
 			},
 		},
+	}, {
+		name:    "controller configuration with concurrency state endpoint",
+		wantErr: false,
+		wantController: &Config{
+			RegistriesSkippingTagResolving: sets.NewString("ko.local", "dev.local"),
+			QueueSidecarImage:              noSidecarImage,
+			ConcurrencyStateEndpoint:       "freeze-proxy",
+		},
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				QueueSidecarImageKey:        noSidecarImage,
+				ConcurrencyStateEndpointKey: "freeze-proxy",
+			},
+		},
+	}, {
+		name:    "controller configuration with progress deadline",
+		wantErr: false,
+		wantController: &Config{
+			RegistriesSkippingTagResolving: sets.NewString("ko.local", "dev.local"),
+			QueueSidecarImage:              noSidecarImage,
+			ProgressDeadline:               444 * time.Second,
+		},
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				QueueSidecarImageKey: noSidecarImage,
+				ProgressDeadlineKey:  "444s",
+			},
+		},
+	}, {
+		name:           "controller configuration with invalid progress deadline",
+		wantErr:        true,
+		wantController: (*Config)(nil),
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				QueueSidecarImageKey: noSidecarImage,
+				ProgressDeadlineKey:  "not-a-duration",
+			},
+		},
+	}, {
+		name:           "controller configuration with non-positive progress deadline",
+		wantErr:        true,
+		wantController: (*Config)(nil),
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				QueueSidecarImageKey: noSidecarImage,
+				ProgressDeadlineKey:  "-1s",
+			},
+		},
+	}, {
+		name:    "controller configuration with drain timeout",
+		wantErr: false,
+		wantController: &Config{
+			RegistriesSkippingTagResolving: sets.NewString("ko.local", "dev.local"),
+			QueueSidecarImage:              noSidecarImage,
+			QueueSidecarDrainTimeout:       45 * time.Second,
+		},
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				QueueSidecarImageKey:        noSidecarImage,
+				QueueSidecarDrainTimeoutKey: "45s",
+			},
+		},
+	}, {
+		name:           "controller configuration with non-positive drain timeout",
+		wantErr:        true,
+		wantController: (*Config)(nil),
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				QueueSidecarImageKey:        noSidecarImage,
+				QueueSidecarDrainTimeoutKey: "-1s",
+			},
+		},
+	}, {
+		name:    "controller configuration with pod labels and annotations",
+		wantErr: false,
+		wantController: &Config{
+			RegistriesSkippingTagResolving: sets.NewString("ko.local", "dev.local"),
+			QueueSidecarImage:              noSidecarImage,
+			PodLabels: map[string]string{
+				"environment": "devel",
+				"team":        "serving",
+			},
+			PodAnnotations: map[string]string{
+				"monitoring.example.com/scrape": "true",
+			},
+		},
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				QueueSidecarImageKey: noSidecarImage,
+				PodLabelsKey:         "environment=devel, team=serving",
+				PodAnnotationsKey:    "monitoring.example.com/scrape=true",
+			},
+		},
+	}, {
+		name:    "controller configuration with queue sidecar resources",
+		wantErr: false,
+		wantController: &Config{
+			RegistriesSkippingTagResolving: sets.NewString("ko.local", "dev.local"),
+			QueueSidecarImage:              noSidecarImage,
+			QueueSidecarCPURequest:         quantityPtr("30m"),
+			QueueSidecarCPULimit:           quantityPtr("2"),
+			QueueSidecarMemoryRequest:      quantityPtr("100Mi"),
+			QueueSidecarMemoryLimit:        quantityPtr("200Mi"),
+			QueueSidecarResourcePercentage: 50,
+		},
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				QueueSidecarImageKey:              noSidecarImage,
+				QueueSidecarCPURequestKey:         "30m",
+				QueueSidecarCPULimitKey:           "2",
+				QueueSidecarMemoryRequestKey:      "100Mi",
+				QueueSidecarMemoryLimitKey:        "200Mi",
+				QueueSidecarResourcePercentageKey: "50",
+			},
+		},
+	}, {
+		name:           "controller configuration with invalid queue sidecar resource",
+		wantErr:        true,
+		wantController: (*Config)(nil),
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				QueueSidecarImageKey:      noSidecarImage,
+				QueueSidecarCPURequestKey: "not-a-quantity",
+			},
+		},
+	}, {
+		name:           "controller configuration with out of range resource percentage",
+		wantErr:        true,
+		wantController: (*Config)(nil),
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				QueueSidecarImageKey:              noSidecarImage,
+				QueueSidecarResourcePercentageKey: "101",
+			},
+		},
+	}, {
+		name:           "controller configuration with invalid pod labels",
+		wantErr:        true,
+		wantController: (*Config)(nil),
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				QueueSidecarImageKey: noSidecarImage,
+				PodLabelsKey:         "no-value-here",
+			},
+		},
 	}, {
 		name:           "controller with no side car image",
 		wantErr:        true,
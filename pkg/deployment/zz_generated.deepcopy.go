@@ -34,6 +34,40 @@ func (in *Config) DeepCopyInto(out *Config) {
 			(*out)[key] = val
 		}
 	}
+	if in.PodLabels != nil {
+		in, out := &in.PodLabels, &out.PodLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PodAnnotations != nil {
+		in, out := &in.PodAnnotations, &out.PodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.QueueSidecarCPURequest != nil {
+		in, out := &in.QueueSidecarCPURequest, &out.QueueSidecarCPURequest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.QueueSidecarCPULimit != nil {
+		in, out := &in.QueueSidecarCPULimit, &out.QueueSidecarCPULimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.QueueSidecarMemoryRequest != nil {
+		in, out := &in.QueueSidecarMemoryRequest, &out.QueueSidecarMemoryRequest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.QueueSidecarMemoryLimit != nil {
+		in, out := &in.QueueSidecarMemoryLimit, &out.QueueSidecarMemoryLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -29,6 +30,12 @@ import (
 
 const (
 	ConfigName = "config-gc"
+
+	// Disabled is the value (-1) the retain settings below take to
+	// indicate the respective limit is turned off.
+	Disabled = -1
+
+	disabledString = "disabled"
 )
 
 type Config struct {
@@ -41,6 +48,19 @@ type Config struct {
 	StaleRevisionMinimumGenerations int64
 	// Minimum staleness duration before updating lastPinned
 	StaleRevisionLastpinnedDebounce time.Duration
+
+	// Duration since creation before a non-active revision may be GC'd.
+	// Disabled when negative.
+	RetainSinceCreateTime time.Duration
+	// Duration since the revision was last active (pinned by a route)
+	// before it may be GC'd. Disabled when negative.
+	RetainSinceLastActiveTime time.Duration
+	// Minimum number of non-active revisions to retain per Configuration.
+	MinNonActiveRevisions int64
+	// Maximum number of non-active revisions to retain per Configuration,
+	// deleting the longest-inactive ones first regardless of the retain
+	// durations. Disabled when negative.
+	MaxNonActiveRevisions int64
 }
 
 func NewConfigFromConfigMapFunc(ctx context.Context) func(configMap *corev1.ConfigMap) (*Config, error) {
@@ -85,6 +105,54 @@ func NewConfigFromConfigMapFunc(ctx context.Context) func(configMap *corev1.Conf
 			c.StaleRevisionMinimumGenerations = val
 		}
 
+		// The retain settings below drive the count-capped collection of
+		// non-active revisions. They accept the special value "disabled"
+		// and, when none of them is set, the legacy stale-revision settings
+		// above apply instead.
+		for _, dur := range []struct {
+			key   string
+			field *time.Duration
+		}{{
+			key:   "retain-since-create-time",
+			field: &c.RetainSinceCreateTime,
+		}, {
+			key:   "retain-since-last-active-time",
+			field: &c.RetainSinceLastActiveTime,
+		}} {
+			if raw, ok := configMap.Data[dur.key]; !ok || strings.EqualFold(raw, disabledString) {
+				*dur.field = Disabled
+			} else if val, err := time.ParseDuration(raw); err != nil {
+				return nil, err
+			} else {
+				*dur.field = val
+			}
+		}
+
+		if raw, ok := configMap.Data["min-non-active-revisions"]; !ok {
+			c.MinNonActiveRevisions = 20
+		} else if val, err := strconv.ParseInt(raw, 10 /*base*/, 64 /*bit count*/); err != nil {
+			return nil, err
+		} else if val < 0 {
+			return nil, fmt.Errorf("min-non-active-revisions must be non-negative, was: %d", val)
+		} else {
+			c.MinNonActiveRevisions = val
+		}
+
+		if raw, ok := configMap.Data["max-non-active-revisions"]; !ok || strings.EqualFold(raw, disabledString) {
+			c.MaxNonActiveRevisions = Disabled
+		} else if val, err := strconv.ParseInt(raw, 10 /*base*/, 64 /*bit count*/); err != nil {
+			return nil, err
+		} else if val < 0 {
+			return nil, fmt.Errorf("max-non-active-revisions must be non-negative, was: %d", val)
+		} else {
+			c.MaxNonActiveRevisions = val
+		}
+
+		if c.MaxNonActiveRevisions != Disabled && c.MinNonActiveRevisions > c.MaxNonActiveRevisions {
+			return nil, fmt.Errorf("max-non-active-revisions(%d) must not be smaller than min-non-active-revisions(%d)",
+				c.MaxNonActiveRevisions, c.MinNonActiveRevisions)
+		}
+
 		if c.StaleRevisionTimeout-c.StaleRevisionLastpinnedDebounce < minRevisionTimeout {
 			logger.Warnf("Got revision timeout of %v, minimum supported value is %v", c.StaleRevisionTimeout, minRevisionTimeout+c.StaleRevisionLastpinnedDebounce)
 			c.StaleRevisionTimeout = minRevisionTimeout + c.StaleRevisionLastpinnedDebounce
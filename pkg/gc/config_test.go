@@ -42,6 +42,10 @@ func TestOurConfig(t *testing.T) {
 			StaleRevisionTimeout:            15 * time.Hour,
 			StaleRevisionMinimumGenerations: 20,
 			StaleRevisionLastpinnedDebounce: 5 * time.Hour,
+			RetainSinceCreateTime:           Disabled,
+			RetainSinceLastActiveTime:       Disabled,
+			MinNonActiveRevisions:           20,
+			MaxNonActiveRevisions:           Disabled,
 		},
 		data: actual,
 	}, {
@@ -52,6 +56,10 @@ func TestOurConfig(t *testing.T) {
 			StaleRevisionTimeout:            15 * time.Hour,
 			StaleRevisionMinimumGenerations: 20,
 			StaleRevisionLastpinnedDebounce: 5 * time.Hour,
+			RetainSinceCreateTime:           48 * time.Hour,
+			RetainSinceLastActiveTime:       15 * time.Hour,
+			MinNonActiveRevisions:           20,
+			MaxNonActiveRevisions:           1000,
 		},
 		data: example,
 	}, {
@@ -61,6 +69,10 @@ func TestOurConfig(t *testing.T) {
 			StaleRevisionTimeout:            15 * time.Hour,
 			StaleRevisionMinimumGenerations: 10,
 			StaleRevisionLastpinnedDebounce: 5 * time.Hour,
+			RetainSinceCreateTime:           Disabled,
+			RetainSinceLastActiveTime:       Disabled,
+			MinNonActiveRevisions:           20,
+			MaxNonActiveRevisions:           Disabled,
 		},
 		data: &corev1.ConfigMap{
 			Data: map[string]string{
@@ -68,6 +80,26 @@ func TestOurConfig(t *testing.T) {
 				"stale-revision-minimum-generations": "10",
 			},
 		},
+	}, {
+		name: "With retain value overrides",
+		want: &Config{
+			StaleRevisionCreateDelay:        48 * time.Hour,
+			StaleRevisionTimeout:            15 * time.Hour,
+			StaleRevisionMinimumGenerations: 20,
+			StaleRevisionLastpinnedDebounce: 5 * time.Hour,
+			RetainSinceCreateTime:           30 * time.Hour,
+			RetainSinceLastActiveTime:       Disabled,
+			MinNonActiveRevisions:           5,
+			MaxNonActiveRevisions:           500,
+		},
+		data: &corev1.ConfigMap{
+			Data: map[string]string{
+				"retain-since-create-time":      "30h",
+				"retain-since-last-active-time": "disabled",
+				"min-non-active-revisions":      "5",
+				"max-non-active-revisions":      "500",
+			},
+		},
 	}, {
 		name: "Invalid duration",
 		fail: true,
@@ -95,6 +127,34 @@ func TestOurConfig(t *testing.T) {
 				"stale-revision-minimum-generations": "invalid",
 			},
 		},
+	}, {
+		name: "Invalid negative minimum non-active revisions",
+		fail: true,
+		want: nil,
+		data: &corev1.ConfigMap{
+			Data: map[string]string{
+				"min-non-active-revisions": "-1",
+			},
+		},
+	}, {
+		name: "Invalid retain duration",
+		fail: true,
+		want: nil,
+		data: &corev1.ConfigMap{
+			Data: map[string]string{
+				"retain-since-create-time": "invalid",
+			},
+		},
+	}, {
+		name: "Maximum smaller than minimum non-active revisions",
+		fail: true,
+		want: nil,
+		data: &corev1.ConfigMap{
+			Data: map[string]string{
+				"min-non-active-revisions": "20",
+				"max-non-active-revisions": "10",
+			},
+		},
 	}, {
 		name: "Below minimum timeout",
 		fail: false,
@@ -103,6 +163,10 @@ func TestOurConfig(t *testing.T) {
 			StaleRevisionTimeout:            15 * time.Hour,
 			StaleRevisionMinimumGenerations: 10,
 			StaleRevisionLastpinnedDebounce: 5 * time.Hour,
+			RetainSinceCreateTime:           Disabled,
+			RetainSinceLastActiveTime:       Disabled,
+			MinNonActiveRevisions:           20,
+			MaxNonActiveRevisions:           Disabled,
 		},
 		data: &corev1.ConfigMap{
 			Data: map[string]string{
@@ -0,0 +1,123 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package health exposes the warm-up state of a controller process over
+// HTTP, so Kubernetes doesn't route work to instances whose informer
+// caches and config stores haven't loaded yet.
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"knative.dev/pkg/controller"
+)
+
+// Probe tracks the pieces a controller process needs before it can do
+// useful work: the initial config maps and every informer cache.
+type Probe struct {
+	mux           sync.RWMutex
+	configsLoaded bool
+	informers     []controller.Informer
+}
+
+// probeState is the JSON document served for a readiness check.
+type probeState struct {
+	ConfigsLoaded bool            `json:"configsLoaded"`
+	Informers     []informerState `json:"informers"`
+}
+
+type informerState struct {
+	Name   string `json:"name"`
+	Synced bool   `json:"synced"`
+}
+
+// NewProbe returns a Probe tracking nothing yet.
+func NewProbe() *Probe {
+	return &Probe{}
+}
+
+// TrackInformers adds the given informers to the set whose cache sync
+// gates readiness.
+func (p *Probe) TrackInformers(informers ...controller.Informer) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.informers = append(p.informers, informers...)
+}
+
+// MarkConfigsLoaded records that the initial configuration has been read.
+func (p *Probe) MarkConfigsLoaded() {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.configsLoaded = true
+}
+
+func (p *Probe) state() probeState {
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+	state := probeState{
+		ConfigsLoaded: p.configsLoaded,
+		Informers:     make([]informerState, 0, len(p.informers)),
+	}
+	for _, informer := range p.informers {
+		state.Informers = append(state.Informers, informerState{
+			Name:   fmt.Sprintf("%T", informer),
+			Synced: informer.HasSynced(),
+		})
+	}
+	return state
+}
+
+// ServeHTTP serves the readiness state as JSON, with a 503 status until
+// the configs are loaded and every tracked informer cache has synced.
+func (p *Probe) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	state := p.state()
+	code := http.StatusOK
+	if !ready(state) {
+		code = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(state)
+}
+
+func ready(state probeState) bool {
+	if !state.ConfigsLoaded {
+		return false
+	}
+	for _, informer := range state.Informers {
+		if !informer.Synced {
+			return false
+		}
+	}
+	return true
+}
+
+// NewServer returns an HTTP server answering liveness checks on /healthz
+// and readiness checks on /readyz at the given address.
+func NewServer(addr string, p *Probe) *http.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.Handle("/readyz", p)
+	return &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeInformer struct {
+	synced bool
+}
+
+func (f *fakeInformer) Run(<-chan struct{}) {}
+
+func (f *fakeInformer) HasSynced() bool {
+	return f.synced
+}
+
+func TestProbe(t *testing.T) {
+	probe := NewProbe()
+	informer := &fakeInformer{}
+	probe.TrackInformers(informer)
+
+	assertReadiness := func(wantCode int) {
+		t.Helper()
+		resp := httptest.NewRecorder()
+		probe.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		if got := resp.Code; got != wantCode {
+			t.Errorf("StatusCode = %d, want %d, body: %s", got, wantCode, resp.Body)
+		}
+	}
+
+	// Neither configs nor informers are ready.
+	assertReadiness(http.StatusServiceUnavailable)
+
+	// Configs alone don't make us ready.
+	probe.MarkConfigsLoaded()
+	assertReadiness(http.StatusServiceUnavailable)
+
+	// Once the informer cache syncs, we are ready.
+	informer.synced = true
+	assertReadiness(http.StatusOK)
+}
+
+func TestProbeState(t *testing.T) {
+	probe := NewProbe()
+	probe.TrackInformers(&fakeInformer{synced: true}, &fakeInformer{})
+	probe.MarkConfigsLoaded()
+
+	resp := httptest.NewRecorder()
+	probe.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if got, want := resp.Code, http.StatusServiceUnavailable; got != want {
+		t.Errorf("StatusCode = %d, want %d", got, want)
+	}
+
+	var state probeState
+	if err := json.NewDecoder(resp.Body).Decode(&state); err != nil {
+		t.Fatalf("Decode() = %v", err)
+	}
+	if !state.ConfigsLoaded {
+		t.Error("ConfigsLoaded = false, want true")
+	}
+	if got, want := len(state.Informers), 2; got != want {
+		t.Fatalf("len(Informers) = %d, want %d", got, want)
+	}
+	if !state.Informers[0].Synced || state.Informers[1].Synced {
+		t.Errorf("Informer sync states = %v, want [synced, unsynced]", state.Informers)
+	}
+}
+
+func TestServer(t *testing.T) {
+	probe := NewProbe()
+	server := NewServer(":0", probe)
+
+	resp := httptest.NewRecorder()
+	server.Handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if got, want := resp.Code, http.StatusOK; got != want {
+		t.Errorf("healthz StatusCode = %d, want %d", got, want)
+	}
+
+	resp = httptest.NewRecorder()
+	server.Handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if got, want := resp.Code, http.StatusServiceUnavailable; got != want {
+		t.Errorf("readyz StatusCode = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package injection augments the knative.dev/pkg injection framework with
+// serving-specific environment wiring.
+package injection
+
+import (
+	"context"
+	"os"
+
+	"knative.dev/pkg/injection"
+)
+
+// NamespaceScopeEnvName is the environment variable restricting the
+// binary's informers, and thereby its reconcilers, to a single namespace.
+// This allows Knative Serving to be run without cluster-wide RBAC. When
+// unset, informers watch the whole cluster.
+const NamespaceScopeEnvName = "NAMESPACE_SCOPE"
+
+// ScopeFromEnv returns ctx scoped to the namespace named in the
+// NAMESPACE_SCOPE environment variable, or ctx unchanged when it is unset.
+// It must be applied before the injection informers are set up.
+func ScopeFromEnv(ctx context.Context) context.Context {
+	if ns := os.Getenv(NamespaceScopeEnvName); ns != "" {
+		return injection.WithNamespaceScope(ctx, ns)
+	}
+	return ctx
+}
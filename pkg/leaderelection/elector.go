@@ -0,0 +1,224 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package leaderelection distributes a set of buckets across replicas
+// using coordination.k8s.io Leases, so that horizontally scaled
+// components can each own a subset of the keys instead of running
+// active-passive.
+package leaderelection
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/ptr"
+	"knative.dev/pkg/system"
+	"knative.dev/serving/pkg/autoscaler/bucket"
+)
+
+const (
+	// How long a bucket Lease is valid without being renewed.
+	leaseDuration = 15 * time.Second
+
+	// How often an Elector tries to acquire or renew bucket Leases.
+	renewInterval = 5 * time.Second
+)
+
+// NewBucketSet returns a BucketSet of the given total count of buckets
+// named after the given component, e.g. controller-bucket-00-of-04.
+func NewBucketSet(component string, total uint32) *bucket.BucketSet {
+	buckets := make([]string, 0, total)
+	for i := uint32(0); i < total; i++ {
+		buckets = append(buckets, fmt.Sprintf("%s-bucket-%02d-of-%02d", component, i, total))
+	}
+	return bucket.NewBucketSet(buckets)
+}
+
+// Elector continuously tries to acquire or renew the Leases of a set of
+// buckets and tracks the current holder of every bucket.
+type Elector struct {
+	identity string
+	logger   *zap.SugaredLogger
+	kc       kubernetes.Interface
+	bs       *bucket.BucketSet
+
+	// onChange, if set, is called whenever the holder of a bucket changes.
+	onChange func(bkt, holder string)
+
+	subscribersLock sync.RWMutex
+	subscribers     []func(bkt, holder string)
+
+	holdersLock sync.RWMutex
+	holders     map[string]string
+}
+
+// New creates an Elector with the given identity and starts the
+// background loop maintaining the bucket Leases. The loop stops when the
+// given context is cancelled.
+func New(ctx context.Context, logger *zap.SugaredLogger, kc kubernetes.Interface,
+	identity string, bs *bucket.BucketSet, onChange func(bkt, holder string)) *Elector {
+	e := &Elector{
+		identity: identity,
+		logger:   logger,
+		kc:       kc,
+		bs:       bs,
+		onChange: onChange,
+		holders:  make(map[string]string),
+	}
+
+	go func() {
+		ticker := time.NewTicker(renewInterval)
+		defer ticker.Stop()
+		e.syncOnce()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				e.syncOnce()
+			}
+		}
+	}()
+
+	return e
+}
+
+// Subscribe registers f to be called whenever the holder of a bucket
+// changes, in addition to any onChange function given at construction.
+func (e *Elector) Subscribe(f func(bkt, holder string)) {
+	e.subscribersLock.Lock()
+	defer e.subscribersLock.Unlock()
+	e.subscribers = append(e.subscribers, f)
+}
+
+// IsOwner returns true if this replica currently holds the Lease of the
+// bucket owning the given key.
+func (e *Elector) IsOwner(key string) bool {
+	bkt := e.bs.Owner(key)
+	e.holdersLock.RLock()
+	defer e.holdersLock.RUnlock()
+	return e.holders[bkt] == e.identity
+}
+
+// syncOnce attempts to acquire or renew the Lease of every bucket and
+// refreshes the tracked holders.
+func (e *Elector) syncOnce() {
+	for _, bkt := range e.bs.BucketList() {
+		holder, err := e.reconcileLease(bkt)
+		if err != nil {
+			e.logger.Errorw("Failed to reconcile Lease", zap.String("bucket", bkt), zap.Error(err))
+			continue
+		}
+		e.setHolder(bkt, holder)
+	}
+}
+
+// reconcileLease tries to acquire or renew the Lease of the given bucket
+// and returns the identity of the current holder.
+func (e *Elector) reconcileLease(bkt string) (string, error) {
+	leases := e.kc.CoordinationV1().Leases(system.Namespace())
+
+	l, err := leases.Get(bkt, metav1.GetOptions{})
+	if apierrs.IsNotFound(err) {
+		l, err = leases.Create(e.newLease(bkt))
+		if apierrs.IsAlreadyExists(err) {
+			l, err = leases.Get(bkt, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	holder := ""
+	if l.Spec.HolderIdentity != nil {
+		holder = *l.Spec.HolderIdentity
+	}
+	if holder != e.identity && !leaseExpired(l) {
+		// Somebody else validly holds this bucket.
+		return holder, nil
+	}
+
+	now := metav1.NewMicroTime(time.Now())
+	l = l.DeepCopy()
+	if holder != e.identity {
+		l.Spec.AcquireTime = &now
+	}
+	l.Spec.HolderIdentity = &e.identity
+	l.Spec.LeaseDurationSeconds = ptr.Int32(int32(leaseDuration.Seconds()))
+	l.Spec.RenewTime = &now
+	if _, err := leases.Update(l); err != nil {
+		if apierrs.IsConflict(err) {
+			// Lost the race against another replica, pick up the
+			// result on the next sync.
+			return holder, nil
+		}
+		return "", err
+	}
+	return e.identity, nil
+}
+
+func (e *Elector) newLease(bkt string) *coordinationv1.Lease {
+	now := metav1.NewMicroTime(time.Now())
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bkt,
+			Namespace: system.Namespace(),
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &e.identity,
+			LeaseDurationSeconds: ptr.Int32(int32(leaseDuration.Seconds())),
+			AcquireTime:          &now,
+			RenewTime:            &now,
+		},
+	}
+}
+
+func (e *Elector) setHolder(bkt, holder string) {
+	e.holdersLock.Lock()
+	changed := e.holders[bkt] != holder
+	if holder == "" {
+		delete(e.holders, bkt)
+	} else {
+		e.holders[bkt] = holder
+	}
+	e.holdersLock.Unlock()
+
+	if !changed {
+		return
+	}
+	if e.onChange != nil {
+		e.onChange(bkt, holder)
+	}
+	e.subscribersLock.RLock()
+	defer e.subscribersLock.RUnlock()
+	for _, f := range e.subscribers {
+		f(bkt, holder)
+	}
+}
+
+func leaseExpired(l *coordinationv1.Lease) bool {
+	if l.Spec.RenewTime == nil || l.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	return time.Now().After(l.Spec.RenewTime.Add(time.Duration(*l.Spec.LeaseDurationSeconds) * time.Second))
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leaderelection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+	"knative.dev/pkg/system"
+
+	. "knative.dev/pkg/logging/testing"
+	_ "knative.dev/pkg/system/testing"
+)
+
+const identity = "controller-abc"
+
+func TestNewBucketSet(t *testing.T) {
+	bs := NewBucketSet("controller", 2)
+	want := []string{"controller-bucket-00-of-02", "controller-bucket-01-of-02"}
+	got := bs.BucketList()
+	if len(got) != len(want) {
+		t.Fatalf("BucketList() = %v, want: %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("BucketList()[%d] = %q, want: %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestElectorAcquiresBuckets(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kc := fake.NewSimpleClientset()
+	e := New(ctx, TestLogger(t), kc, identity, NewBucketSet("controller", 2), nil)
+
+	if err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return e.IsOwner("default/some-route") && e.IsOwner("foo/another"), nil
+	}); err != nil {
+		t.Fatal("Timed out waiting to own all buckets:", err)
+	}
+
+	for _, bkt := range e.bs.BucketList() {
+		l, err := kc.CoordinationV1().Leases(system.Namespace()).Get(bkt, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Lease for %s not created: %v", bkt, err)
+		}
+		if got := *l.Spec.HolderIdentity; got != identity {
+			t.Errorf("Lease holder for %s = %q, want: %q", bkt, got, identity)
+		}
+	}
+}
+
+func TestElectorRespectsHeldLease(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bs := NewBucketSet("controller", 1)
+	bkt := bs.BucketList()[0]
+	now := metav1.NewMicroTime(time.Now())
+	kc := fake.NewSimpleClientset(&coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bkt,
+			Namespace: system.Namespace(),
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.String("somebody-else"),
+			LeaseDurationSeconds: ptr.Int32(1000),
+			RenewTime:            &now,
+		},
+	})
+
+	changeCh := make(chan string, 1)
+	New(ctx, TestLogger(t), kc, identity, bs, func(bkt, holder string) {
+		changeCh <- holder
+	})
+
+	select {
+	case holder := <-changeCh:
+		if holder != "somebody-else" {
+			t.Errorf("Holder = %q, want: somebody-else", holder)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the holder callback")
+	}
+
+	l, err := kc.CoordinationV1().Leases(system.Namespace()).Get(bkt, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal("Lease not found:", err)
+	}
+	if got := *l.Spec.HolderIdentity; got != "somebody-else" {
+		t.Errorf("Lease holder = %q, want: somebody-else", got)
+	}
+}
+
+func TestElectorNotifiesSubscribers(t *testing.T) {
+	e := &Elector{identity: identity, holders: make(map[string]string)}
+	changeCh := make(chan string, 2)
+	e.Subscribe(func(bkt, holder string) { changeCh <- holder })
+
+	e.setHolder("bkt", "somebody-else")
+	e.setHolder("bkt", "somebody-else") // No change, no notification.
+	e.setHolder("bkt", identity)
+
+	if got := <-changeCh; got != "somebody-else" {
+		t.Errorf("First holder = %q, want: somebody-else", got)
+	}
+	if got := <-changeCh; got != identity {
+		t.Errorf("Second holder = %q, want: %q", got, identity)
+	}
+}
+
+func TestElectorTakesExpiredLease(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bs := NewBucketSet("controller", 1)
+	bkt := bs.BucketList()[0]
+	expired := metav1.NewMicroTime(time.Now().Add(-time.Minute))
+	kc := fake.NewSimpleClientset(&coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bkt,
+			Namespace: system.Namespace(),
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.String("somebody-else"),
+			LeaseDurationSeconds: ptr.Int32(15),
+			RenewTime:            &expired,
+		},
+	})
+
+	e := New(ctx, TestLogger(t), kc, identity, bs, nil)
+
+	if err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return e.IsOwner("default/some-route"), nil
+	}); err != nil {
+		t.Fatal("Timed out waiting to take over the expired Lease:", err)
+	}
+}
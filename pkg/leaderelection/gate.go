@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leaderelection
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+// bucketCountEnvName is the environment variable enabling bucketed
+// leader election for the controller process. All replicas must agree
+// on the value.
+const bucketCountEnvName = "CONTROLLER_BUCKET_COUNT"
+
+var (
+	electorOnce   sync.Once
+	sharedElector *Elector
+)
+
+// GateReconciler wraps the Impl's Reconciler so that keys hashing into
+// buckets owned by other controller replicas are skipped. Whenever the
+// holder of a bucket changes, the given informer is globally resynced so
+// that the new owner picks up the keys it just acquired instead of
+// waiting for the next informer event. It is a no-op unless bucketed
+// leader election is enabled via CONTROLLER_BUCKET_COUNT.
+func GateReconciler(ctx context.Context, impl *controller.Impl, si cache.SharedInformer) {
+	electorOnce.Do(func() { setupSharedElector(ctx) })
+	if sharedElector == nil {
+		return
+	}
+	impl.Reconciler = Gate(sharedElector, impl.Reconciler)
+	sharedElector.Subscribe(func(string, string) { impl.GlobalResync(si) })
+}
+
+// Gate wraps the given Reconciler so that keys hashing into buckets
+// owned by other replicas per the given Elector are skipped.
+func Gate(e *Elector, r controller.Reconciler) controller.Reconciler {
+	return &gatedReconciler{elector: e, delegate: r}
+}
+
+// setupSharedElector creates the Elector shared by all gated reconcilers
+// of this process, if bucketed leader election is enabled. The pod's
+// hostname (i.e. the pod name) serves as the election identity.
+func setupSharedElector(ctx context.Context) {
+	v := os.Getenv(bucketCountEnvName)
+	if v == "" {
+		return
+	}
+	logger := logging.FromContext(ctx)
+	count, err := strconv.ParseUint(v, 10, 32)
+	if err != nil || count < 1 {
+		logger.Fatalf("%s must be a positive integer, got %q", bucketCountEnvName, v)
+	}
+	if count == 1 {
+		return
+	}
+	identity, err := os.Hostname()
+	if err != nil {
+		logger.Fatalw("Failed to determine the pod hostname", zap.Error(err))
+	}
+	logger.Infof("Enabling bucketed leader election across %d buckets as %q", count, identity)
+	sharedElector = New(ctx, logger, kubeclient.Get(ctx), identity,
+		NewBucketSet("controller", uint32(count)), nil /*onChange*/)
+}
+
+type gatedReconciler struct {
+	elector  *Elector
+	delegate controller.Reconciler
+}
+
+// Reconcile implements controller.Reconciler.
+func (g *gatedReconciler) Reconcile(ctx context.Context, key string) error {
+	if !g.elector.IsOwner(key) {
+		return nil
+	}
+	return g.delegate.Reconcile(ctx, key)
+}
@@ -18,12 +18,15 @@ package metrics
 
 import (
 	"context"
+	"os"
 	"strconv"
 
 	lru "github.com/hashicorp/golang-lru"
 	"k8s.io/apimachinery/pkg/types"
 	"knative.dev/pkg/metrics/metricskey"
 
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
 	"go.opencensus.io/tag"
 )
 
@@ -32,14 +35,47 @@ var (
 	// in an LRU cache.
 	// Hashicorp LRU cache is synchronized.
 	contextCache *lru.Cache
+
+	// cacheEvictionM counts reporting contexts evicted from the cache.
+	// A steadily growing count means the cap is smaller than the number
+	// of live revisions, i.e. the cardinality limit is in effect.
+	cacheEvictionM = stats.Int64(
+		"metrics_context_cache_evictions",
+		"Number of metric reporting contexts evicted from the LRU cache",
+		stats.UnitDimensionless)
 )
 
-const lruCacheSize = 1024
+const (
+	// defaultLruCacheSize caps the number of cached reporting contexts,
+	// and with them the number of live per-revision time series a
+	// reporter keeps warm.
+	defaultLruCacheSize = 1024
+
+	// cacheSizeEnvName overrides the reporting context cache size, for
+	// clusters whose revision count doesn't fit the default cap.
+	cacheSizeEnvName = "METRICS_CONTEXT_CACHE_SIZE"
+)
 
 func init() {
+	size := defaultLruCacheSize
+	if v := os.Getenv(cacheSizeEnvName); v != "" {
+		if s, err := strconv.Atoi(v); err == nil && s > 0 {
+			size = s
+		}
+	}
 	// The only possible error is when cache size is not positive.
-	lc, _ := lru.New(lruCacheSize)
+	lc, _ := lru.NewWithEvict(size, func(interface{}, interface{}) {
+		stats.Record(context.Background(), cacheEvictionM.M(1))
+	})
 	contextCache = lc
+
+	if err := view.Register(&view.View{
+		Description: "Number of metric reporting contexts evicted from the LRU cache",
+		Measure:     cacheEvictionM,
+		Aggregation: view.Count(),
+	}); err != nil {
+		panic(err)
+	}
 }
 
 func valueOrUnknown(v string) string {
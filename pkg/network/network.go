@@ -24,8 +24,10 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 )
@@ -51,6 +53,11 @@ const (
 	// at the Queue proxy level back to be a host header.
 	OriginalHostHeader = "K-Original-Host"
 
+	// RequestIDHeaderName is the name of the header carrying an opaque
+	// identifier for a single request, used to correlate that request's
+	// activator, queue-proxy and user container logs.
+	RequestIDHeaderName = "X-Request-Id"
+
 	// ConfigName is the name of the configmap containing all
 	// customizations for networking features.
 	ConfigName = "config-network"
@@ -74,6 +81,10 @@ const (
 	// Ingress reconciler.
 	IstioIngressClassName = "istio.ingress.networking.knative.dev"
 
+	// GatewayAPIIngressClassName value for specifying knative's Gateway
+	// API Ingress reconciler.
+	GatewayAPIIngressClassName = "gateway-api.ingress.networking.knative.dev"
+
 	// CertManagerCertificateClassName value for specifying Knative's Cert-Manager
 	// Certificate reconciler.
 	CertManagerCertificateClassName = "cert-manager.certificate.networking.internal.knative.dev"
@@ -101,7 +112,10 @@ const (
 	DefaultDomainTemplate = "{{.Name}}.{{.Namespace}}.{{.Domain}}"
 
 	// DefaultTagTemplate is the default golang template to use when
-	// constructing the Knative Route's tag names.
+	// constructing the Knative Route's tag names.  Combined with the
+	// domain template above, a tagged target is reachable at
+	// <tag>-<route>.<namespace>.<domain> before any traffic is shifted
+	// to it.
 	DefaultTagTemplate = "{{.Tag}}-{{.Name}}"
 
 	// AutoTLSKey is the name of the configuration entry
@@ -112,6 +126,21 @@ const (
 	// specifies the HTTP endpoint behavior of Knative ingress.
 	HTTPProtocolKey = "httpProtocol"
 
+	// RolloutDurationKey is the name of the configuration entry that
+	// specifies the default number of seconds over which to shift
+	// traffic to a Configuration's new latest ready Revision.
+	RolloutDurationKey = "rollout-duration"
+
+	// ActivatorLBPolicyKey is the name of the configuration entry that
+	// specifies the load balancing policy the activator uses to pick a
+	// backend pod for a request.
+	ActivatorLBPolicyKey = "activator-lb-policy"
+
+	// ActivatorAuthorizationURLKey is the name of the configuration entry
+	// that specifies the HTTP(S) endpoint the activator consults to
+	// authorize a request before activating a scaled-to-zero revision.
+	ActivatorAuthorizationURLKey = "activator-authorization-url"
+
 	// UserAgentKey is the constant for header "User-Agent".
 	UserAgentKey = "User-Agent"
 
@@ -177,6 +206,21 @@ type Config struct {
 
 	// DefaultCertificateClass specifies the default Certificate class.
 	DefaultCertificateClass string
+
+	// ActivatorLBPolicy specifies the load balancing policy the activator
+	// uses to pick a backend pod for a request.
+	ActivatorLBPolicy LBPolicy
+
+	// ActivatorAuthorizationURL is the HTTP(S) endpoint the activator
+	// consults to authorize a request before activating a scaled-to-zero
+	// revision.  Empty means no authorization check is performed.
+	ActivatorAuthorizationURL string
+
+	// RolloutDuration is the default duration over which the route
+	// reconciler gradually shifts traffic from a Configuration's previous
+	// latest ready Revision to the new one.  Zero means traffic is
+	// shifted immediately.
+	RolloutDuration time.Duration
 }
 
 // HTTPProtocol indicates a type of HTTP endpoint behavior
@@ -194,6 +238,33 @@ const (
 	HTTPRedirected HTTPProtocol = "redirected"
 )
 
+// LBPolicy is a load balancing policy the activator uses to pick a
+// backend pod for a request.
+type LBPolicy string
+
+const (
+	// LBPolicyDefault lets the activator pick the policy based on the
+	// revision's container concurrency: first-available when concurrency
+	// is limited, random otherwise.
+	LBPolicyDefault LBPolicy = ""
+
+	// LBPolicyFirstAvailable picks the first pod with open capacity.
+	LBPolicyFirstAvailable LBPolicy = "first-available"
+
+	// LBPolicyRoundRobin cycles through the pods.
+	LBPolicyRoundRobin LBPolicy = "round-robin"
+
+	// LBPolicyLeastInFlight picks the pod with the fewest requests in flight.
+	LBPolicyLeastInFlight LBPolicy = "least-in-flight"
+
+	// LBPolicyRandomChoice2 picks two pods at random and sends the request
+	// to the one with fewer requests in flight.
+	LBPolicyRandomChoice2 LBPolicy = "random-choice-2"
+
+	// LBPolicyRandom picks a pod uniformly at random.
+	LBPolicyRandom LBPolicy = "random"
+)
+
 func validateAndNormalizeOutboundIPRanges(s string) (string, error) {
 	s = strings.TrimSpace(s)
 
@@ -251,7 +322,7 @@ func NewConfigFromConfigMap(configMap *corev1.ConfigMap) (*Config, error) {
 		if err != nil {
 			return nil, err
 		}
-		if err := checkDomainTemplate(t); err != nil {
+		if err := CheckDomainTemplate(t); err != nil {
 			return nil, err
 		}
 
@@ -273,6 +344,14 @@ func NewConfigFromConfigMap(configMap *corev1.ConfigMap) (*Config, error) {
 		nc.TagTemplate = tt
 	}
 
+	if rd, ok := configMap.Data[RolloutDurationKey]; ok {
+		seconds, err := strconv.Atoi(rd)
+		if err != nil || seconds < 0 {
+			return nil, fmt.Errorf("%s must be a non-negative number of seconds, got %q", RolloutDurationKey, rd)
+		}
+		nc.RolloutDuration = time.Duration(seconds) * time.Second
+	}
+
 	nc.AutoTLS = strings.EqualFold(configMap.Data[AutoTLSKey], "enabled")
 
 	switch strings.ToLower(configMap.Data[HTTPProtocolKey]) {
@@ -289,6 +368,22 @@ func NewConfigFromConfigMap(configMap *corev1.ConfigMap) (*Config, error) {
 	default:
 		return nil, fmt.Errorf("httpProtocol %s in config-network ConfigMap is not supported", configMap.Data[HTTPProtocolKey])
 	}
+
+	switch lb := LBPolicy(strings.ToLower(configMap.Data[ActivatorLBPolicyKey])); lb {
+	case LBPolicyDefault, LBPolicyFirstAvailable, LBPolicyRoundRobin,
+		LBPolicyLeastInFlight, LBPolicyRandomChoice2, LBPolicyRandom:
+		nc.ActivatorLBPolicy = lb
+	default:
+		return nil, fmt.Errorf("activator-lb-policy %s in config-network ConfigMap is not supported", configMap.Data[ActivatorLBPolicyKey])
+	}
+
+	if authURL, ok := configMap.Data[ActivatorAuthorizationURLKey]; ok && authURL != "" {
+		u, err := url.Parse(authURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("activator-authorization-url %s in config-network ConfigMap is not an absolute HTTP(S) URL", authURL)
+		}
+		nc.ActivatorAuthorizationURL = authURL
+	}
 	return nc, nil
 }
 
@@ -300,7 +395,11 @@ func (c *Config) GetDomainTemplate() *template.Template {
 		c.DomainTemplate))
 }
 
-func checkDomainTemplate(t *template.Template) error {
+// CheckDomainTemplate checks that the domain template produces
+// a valid hostname.  It is exported so that callers accepting
+// domain templates from sources other than config-network (e.g.
+// the Route annotation) can apply the same validation.
+func CheckDomainTemplate(t *template.Template) error {
 	// To a test run of applying the template, and see if the
 	// result is a valid URL.
 	data := DomainTemplateValues{
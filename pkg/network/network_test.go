@@ -24,6 +24,7 @@ import (
 	"reflect"
 	"testing"
 	"text/template"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -508,6 +509,132 @@ func TestConfiguration(t *testing.T) {
 				HTTPProtocolKey:          "Redirected",
 			},
 		},
+	}, {
+		name:    "network configuration with activator LB policy",
+		wantErr: false,
+		wantConfig: &Config{
+			IstioOutboundIPRanges:   "*",
+			DefaultIngressClass:     "istio.ingress.networking.knative.dev",
+			DefaultCertificateClass: CertManagerCertificateClassName,
+			DomainTemplate:          DefaultDomainTemplate,
+			TagTemplate:             DefaultTagTemplate,
+			HTTPProtocol:            HTTPEnabled,
+			ActivatorLBPolicy:       LBPolicyRandomChoice2,
+		},
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				IstioOutboundIPRangesKey: "*",
+				ActivatorLBPolicyKey:     "random-choice-2",
+			},
+		},
+	}, {
+		name:    "network configuration with invalid activator LB policy",
+		wantErr: true,
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				ActivatorLBPolicyKey: "fastest-pod-wins",
+			},
+		},
+	}, {
+		name:    "network configuration with rollout duration",
+		wantErr: false,
+		wantConfig: &Config{
+			IstioOutboundIPRanges:   "*",
+			DefaultIngressClass:     "istio.ingress.networking.knative.dev",
+			DefaultCertificateClass: CertManagerCertificateClassName,
+			DomainTemplate:          DefaultDomainTemplate,
+			TagTemplate:             DefaultTagTemplate,
+			HTTPProtocol:            HTTPEnabled,
+			RolloutDuration:         120 * time.Second,
+		},
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				IstioOutboundIPRangesKey: "*",
+				RolloutDurationKey:       "120",
+			},
+		},
+	}, {
+		name:    "network configuration with negative rollout duration",
+		wantErr: true,
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				RolloutDurationKey: "-7",
+			},
+		},
+	}, {
+		name:    "network configuration with malformed rollout duration",
+		wantErr: true,
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				RolloutDurationKey: "two minutes",
+			},
+		},
+	}, {
+		name:    "network configuration with activator authorization URL",
+		wantErr: false,
+		wantConfig: &Config{
+			IstioOutboundIPRanges:     "*",
+			DefaultIngressClass:       "istio.ingress.networking.knative.dev",
+			DefaultCertificateClass:   CertManagerCertificateClassName,
+			DomainTemplate:            DefaultDomainTemplate,
+			TagTemplate:               DefaultTagTemplate,
+			HTTPProtocol:              HTTPEnabled,
+			ActivatorAuthorizationURL: "https://auth.example.com/check",
+		},
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				IstioOutboundIPRangesKey:     "*",
+				ActivatorAuthorizationURLKey: "https://auth.example.com/check",
+			},
+		},
+	}, {
+		name:    "network configuration with relative activator authorization URL",
+		wantErr: true,
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				ActivatorAuthorizationURLKey: "/check",
+			},
+		},
+	}, {
+		name:    "network configuration with non-HTTP activator authorization URL",
+		wantErr: true,
+		config: &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: system.Namespace(),
+				Name:      ConfigName,
+			},
+			Data: map[string]string{
+				ActivatorAuthorizationURLKey: "grpc://auth.example.com",
+			},
+		},
 	}}
 
 	for _, tt := range networkConfigTests {
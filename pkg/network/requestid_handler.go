@@ -0,0 +1,46 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+type requestIDHandler struct {
+	next http.Handler
+}
+
+// NewRequestIDHandler wraps an HTTP handler to guarantee the request
+// carries an 'X-Request-Id' header, generating one when the client did
+// not supply it.  The identifier is also echoed in the response so
+// callers can quote it when chasing a request through the activator,
+// queue-proxy and user container logs.
+func NewRequestIDHandler(next http.Handler) http.Handler {
+	return &requestIDHandler{next: next}
+}
+
+func (h *requestIDHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := r.Header.Get(RequestIDHeaderName)
+	if id == "" {
+		id = uuid.New().String()
+		r.Header.Set(RequestIDHeaderName, id)
+	}
+	w.Header().Set(RequestIDHeaderName, id)
+	h.next.ServeHTTP(w, r)
+}
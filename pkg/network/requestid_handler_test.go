@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestIDHandler(t *testing.T) {
+	var seen string
+	var h http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = r.Header.Get(RequestIDHeaderName)
+	})
+	h = NewRequestIDHandler(h)
+
+	t.Run("generates an id when absent", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		h.ServeHTTP(resp, req)
+
+		if seen == "" {
+			t.Errorf("%s header was not set on the forwarded request", RequestIDHeaderName)
+		}
+		if got, want := resp.Header().Get(RequestIDHeaderName), seen; got != want {
+			t.Errorf("Response %s = %q, want %q", RequestIDHeaderName, got, want)
+		}
+	})
+
+	t.Run("keeps the id the client supplied", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		req.Header.Set(RequestIDHeaderName, "my-id")
+		h.ServeHTTP(resp, req)
+
+		if got, want := seen, "my-id"; got != want {
+			t.Errorf("Forwarded %s = %q, want %q", RequestIDHeaderName, got, want)
+		}
+		if got, want := resp.Header().Get(RequestIDHeaderName), "my-id"; got != want {
+			t.Errorf("Response %s = %q, want %q", RequestIDHeaderName, got, want)
+		}
+	})
+}
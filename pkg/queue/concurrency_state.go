@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// ConcurrencyStateHandler tracks the in-flight requests of the pod.  When
+// they drop to zero it calls the pause function, and when they scale up
+// from zero it calls the resume function.  This allows runtimes that
+// freeze the container CPU (or bill per request) to hook into the request
+// path.
+//
+// The hooks are invoked while holding the lock, so transitions are strictly
+// ordered.  A failing resume fails the request, since serving it without
+// having thawed the container would hang; a failing pause is only logged.
+func ConcurrencyStateHandler(logger *zap.SugaredLogger, next http.Handler, pause, resume func() error) http.HandlerFunc {
+	var (
+		mux      sync.Mutex
+		inFlight int
+	)
+	return func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		if inFlight == 0 {
+			if err := resume(); err != nil {
+				mux.Unlock()
+				logger.Errorw("Error calling the concurrency state resume hook", zap.Error(err))
+				http.Error(w, "concurrency state resume hook failed", http.StatusInternalServerError)
+				return
+			}
+		}
+		inFlight++
+		mux.Unlock()
+
+		defer func() {
+			mux.Lock()
+			inFlight--
+			if inFlight == 0 {
+				if err := pause(); err != nil {
+					logger.Errorw("Error calling the concurrency state pause hook", zap.Error(err))
+				}
+			}
+			mux.Unlock()
+		}()
+
+		next.ServeHTTP(w, r)
+	}
+}
+
+// ConcurrencyStateHooks returns the pause and resume functions for the
+// given concurrency state endpoint.  Each posts a JSON body of the form
+// {"action": "pause"} or {"action": "resume"} to the endpoint.
+func ConcurrencyStateHooks(endpoint string) (pause, resume func() error) {
+	return concurrencyStateRequest(endpoint, "pause"), concurrencyStateRequest(endpoint, "resume")
+}
+
+func concurrencyStateRequest(endpoint, action string) func() error {
+	body, _ := json.Marshal(map[string]string{"action": action})
+	return func() error {
+		resp, err := http.Post(endpoint, "application/json", bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("concurrency state endpoint returned status %d for action %q", resp.StatusCode, action)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestConcurrencyStateHandler(t *testing.T) {
+	var pauses, resumes int
+	pause := func() error {
+		pauses++
+		return nil
+	}
+	resume := func() error {
+		resumes++
+		return nil
+	}
+
+	h := ConcurrencyStateHandler(zap.NewNop().Sugar(),
+		http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}),
+		pause, resume)
+
+	for i := 0; i < 3; i++ {
+		resp := httptest.NewRecorder()
+		h(resp, httptest.NewRequest(http.MethodGet, "/", nil))
+		if got, want := resp.Code, http.StatusOK; got != want {
+			t.Errorf("Status = %d, want: %d", got, want)
+		}
+	}
+
+	if got, want := resumes, 3; got != want {
+		t.Errorf("Resume hook called %d times, want: %d", got, want)
+	}
+	if got, want := pauses, 3; got != want {
+		t.Errorf("Pause hook called %d times, want: %d", got, want)
+	}
+}
+
+func TestConcurrencyStateHandlerResumeFailure(t *testing.T) {
+	var handled bool
+	h := ConcurrencyStateHandler(zap.NewNop().Sugar(),
+		http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			handled = true
+		}),
+		func() error { return nil },
+		func() error { return errors.New("resume failure") })
+
+	resp := httptest.NewRecorder()
+	h(resp, httptest.NewRequest(http.MethodGet, "/", nil))
+	if got, want := resp.Code, http.StatusInternalServerError; got != want {
+		t.Errorf("Status = %d, want: %d", got, want)
+	}
+	if handled {
+		t.Error("Expected the request not to reach the next handler")
+	}
+}
+
+func TestConcurrencyStateHooks(t *testing.T) {
+	var actions []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal("Failed to decode request body:", err)
+		}
+		actions = append(actions, body["action"])
+	}))
+	defer ts.Close()
+
+	pause, resume := ConcurrencyStateHooks(ts.URL)
+	if err := resume(); err != nil {
+		t.Error("resume() =", err)
+	}
+	if err := pause(); err != nil {
+		t.Error("pause() =", err)
+	}
+	if got, want := actions, []string{"resume", "pause"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Actions = %v, want: %v", got, want)
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package health
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net"
@@ -24,6 +25,8 @@ import (
 	"net/url"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	corev1 "k8s.io/api/core/v1"
 	"knative.dev/serving/pkg/network"
 )
@@ -42,6 +45,13 @@ type TCPProbeConfigOptions struct {
 	Address       string
 }
 
+// GRPCProbeConfigOptions holds the gRPC probe config options
+type GRPCProbeConfigOptions struct {
+	Timeout time.Duration
+	Address string
+	Service string
+}
+
 // TCPProbe checks that a TCP socket to the address can be opened.
 // Did not reuse k8s.io/kubernetes/pkg/probe/tcp to not create a dependency
 // on klog.
@@ -54,6 +64,33 @@ func TCPProbe(config TCPProbeConfigOptions) error {
 	return nil
 }
 
+// GRPCProbe checks that the standard gRPC health service at the address
+// reports SERVING for the configured service name. An empty service name
+// queries the server's overall health.
+func GRPCProbe(config GRPCProbeConfigOptions) error {
+	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, config.Address, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{
+		Service: config.Service,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("gRPC probe did not respond SERVING, got status: %s", resp.Status)
+	}
+
+	return nil
+}
+
 // HTTPProbe checks that HTTP connection can be established to the address.
 func HTTPProbe(config HTTPProbeConfigOptions) error {
 	httpClient := &http.Client{
@@ -17,6 +17,8 @@ limitations under the License.
 package health
 
 import (
+	"context"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -25,6 +27,8 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"knative.dev/serving/pkg/network"
@@ -51,6 +55,53 @@ func TestTCPProbe(t *testing.T) {
 	}
 }
 
+func TestGRPCProbe(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, healthServer{})
+	go server.Serve(l)
+	defer server.Stop()
+
+	config := GRPCProbeConfigOptions{
+		Address: l.Addr().String(),
+		Timeout: time.Second,
+	}
+	// The overall server health is SERVING.
+	if err := GRPCProbe(config); err != nil {
+		t.Errorf("Probe failed with: %v", err)
+	}
+
+	// An unhealthy service should fail the probe.
+	config.Service = "unhealthy"
+	if err := GRPCProbe(config); err == nil {
+		t.Error("Expected probe to fail but it didn't")
+	}
+
+	// Stop the server so probing fails afterwards.
+	config.Service = ""
+	server.Stop()
+	if err := GRPCProbe(config); err == nil {
+		t.Error("Expected probe to fail but it didn't")
+	}
+}
+
+type healthServer struct{}
+
+func (healthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	status := grpc_health_v1.HealthCheckResponse_SERVING
+	if req.Service != "" {
+		status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: status}, nil
+}
+
+func (healthServer) Watch(req *grpc_health_v1.HealthCheckRequest, srv grpc_health_v1.Health_WatchServer) error {
+	return srv.Send(&grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING})
+}
+
 func TestHTTPProbeSuccess(t *testing.T) {
 	var gotHeader corev1.HTTPHeader
 	var gotKubeletHeader bool
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -57,6 +58,15 @@ var (
 	processUptimeGV = newGV(
 		"process_uptime",
 		"The number of seconds that the process has been up")
+	queueDepthGV = newGV(
+		"queue_depth",
+		"Number of requests in the serving and waiting queue of this pod")
+	averageQueueingLatencyGV = newGV(
+		"queue_average_queueing_latency_ms",
+		"Average time in milliseconds requests spent in the queue before being served")
+	rejectedRequestsPerSecondGV = newGV(
+		"queue_requests_rejected_per_second",
+		"Number of requests per second rejected because the queue was full")
 )
 
 func newGV(n, h string) *prometheus.GaugeVec {
@@ -77,6 +87,15 @@ type PrometheusStatsReporter struct {
 	averageConcurrentRequests        prometheus.Gauge
 	averageProxiedConcurrentRequests prometheus.Gauge
 	processUptime                    prometheus.Gauge
+	queueDepth                       prometheus.Gauge
+	averageQueueingLatency           prometheus.Gauge
+	rejectedRequestsPerSecond        prometheus.Gauge
+
+	// Accumulated between reports, drained on every Report call.
+	// Accessed via sync/atomic since requests are handled concurrently.
+	queuedRequests       int64
+	queueingLatencySumMs int64
+	rejectedRequests     int64
 }
 
 // NewPrometheusStatsReporter creates a reporter that collects and reports queue metrics.
@@ -98,7 +117,8 @@ func NewPrometheusStatsReporter(namespace, config, revision, pod string, reporti
 	for _, gv := range []*prometheus.GaugeVec{
 		requestsPerSecondGV, proxiedRequestsPerSecondGV,
 		averageConcurrentRequestsGV, averageProxiedConcurrentRequestsGV,
-		processUptimeGV} {
+		processUptimeGV, queueDepthGV, averageQueueingLatencyGV,
+		rejectedRequestsPerSecondGV} {
 		if err := registry.Register(gv); err != nil {
 			return nil, fmt.Errorf("register metric failed: %w", err)
 		}
@@ -121,9 +141,31 @@ func NewPrometheusStatsReporter(namespace, config, revision, pod string, reporti
 		averageConcurrentRequests:        averageConcurrentRequestsGV.With(labels),
 		averageProxiedConcurrentRequests: averageProxiedConcurrentRequestsGV.With(labels),
 		processUptime:                    processUptimeGV.With(labels),
+		queueDepth:                       queueDepthGV.With(labels),
+		averageQueueingLatency:           averageQueueingLatencyGV.With(labels),
+		rejectedRequestsPerSecond:        rejectedRequestsPerSecondGV.With(labels),
 	}, nil
 }
 
+// ReportQueueDepth records the current number of requests in the serving
+// and waiting queue of this pod.
+func (r *PrometheusStatsReporter) ReportQueueDepth(depth int) {
+	r.queueDepth.Set(float64(depth))
+}
+
+// ReportQueueingLatency records the time a request spent in the queue
+// before it was handed to the application.
+func (r *PrometheusStatsReporter) ReportQueueingLatency(latency time.Duration) {
+	atomic.AddInt64(&r.queuedRequests, 1)
+	atomic.AddInt64(&r.queueingLatencySumMs, latency.Milliseconds())
+}
+
+// ReportRejectedRequest records a request that was rejected because the
+// queue was full.
+func (r *PrometheusStatsReporter) ReportRejectedRequest() {
+	atomic.AddInt64(&r.rejectedRequests, 1)
+}
+
 // Report captures request metrics.
 func (r *PrometheusStatsReporter) Report(acr float64, apcr float64, rc float64, prc float64) {
 	// Requests per second is a rate over time while concurrency is not.
@@ -133,6 +175,14 @@ func (r *PrometheusStatsReporter) Report(acr float64, apcr float64, rc float64,
 	r.averageConcurrentRequests.Set(acr)
 	r.averageProxiedConcurrentRequests.Set(apcr)
 	r.processUptime.Set(time.Since(r.startTime).Seconds())
+
+	r.rejectedRequestsPerSecond.Set(float64(atomic.SwapInt64(&r.rejectedRequests, 0)) / rp)
+	latencySum := atomic.SwapInt64(&r.queueingLatencySumMs, 0)
+	if queued := atomic.SwapInt64(&r.queuedRequests, 0); queued > 0 {
+		r.averageQueueingLatency.Set(float64(latencySum) / float64(queued))
+	} else {
+		r.averageQueueingLatency.Set(0)
+	}
 }
 
 // Handler returns an uninstrumented http.Handler used to serve stats registered by this
@@ -180,6 +180,30 @@ func TestReporterReport(t *testing.T) {
 	}
 }
 
+func TestReporterReportQueueMetrics(t *testing.T) {
+	reporter, err := NewPrometheusStatsReporter(namespace, config, revision, pod, 2*time.Second)
+	if err != nil {
+		t.Fatal("Something went wrong with creating a reporter:", err)
+	}
+
+	reporter.ReportQueueDepth(7)
+	reporter.ReportQueueingLatency(10 * time.Millisecond)
+	reporter.ReportQueueingLatency(30 * time.Millisecond)
+	for i := 0; i < 4; i++ {
+		reporter.ReportRejectedRequest()
+	}
+	reporter.Report(0, 0, 0, 0)
+
+	checkData(t, queueDepthGV, 7)
+	checkData(t, averageQueueingLatencyGV, 20)
+	checkData(t, rejectedRequestsPerSecondGV, 2)
+
+	// The accumulated counters are drained on every report.
+	reporter.Report(0, 0, 0, 0)
+	checkData(t, averageQueueingLatencyGV, 0)
+	checkData(t, rejectedRequestsPerSecondGV, 0)
+}
+
 func checkData(t *testing.T, gv *prometheus.GaugeVec, want float64) {
 	t.Helper()
 	g, err := gv.GetMetricWith(prometheus.Labels{
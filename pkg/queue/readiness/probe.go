@@ -32,7 +32,10 @@ const (
 	aggressiveProbeTimeout = 100 * time.Millisecond
 	// PollTimeout is set equal to the queue-proxy's ExecProbe timeout to take
 	// advantage of the full window
-	PollTimeout   = 10 * time.Second
+	PollTimeout = 10 * time.Second
+	// retryInterval is the polling interval for the user container's probe
+	// during startup. Polling at sub-second granularity here is what gets
+	// readiness latency below the kubelet's 1s probe period.
 	retryInterval = 50 * time.Millisecond
 )
 
@@ -28,11 +28,17 @@ import (
 	"knative.dev/pkg/websocket"
 )
 
-// TimeToFirstByteTimeoutHandler returns a Handler that runs `h` with the
-// given time limit in which the first byte of the response must be written.
+// NewTimeoutHandler returns a Handler that runs `h` with the given time
+// limits. `timeout` and `responseStartTimeout` both bound the time in
+// which the first byte of the response must be written, while
+// `idleTimeout` bounds the time between consecutive writes. Once the
+// response has started, only the idle timeout can end it early, so
+// long-lived streaming responses are not cut off by the request
+// timeout. A zero `responseStartTimeout` or `idleTimeout` disables the
+// respective limit.
 //
 // The new Handler calls h.ServeHTTP to handle each request, but if a
-// call runs for longer than its time limit, the handler responds with
+// call exceeds one of its time limits, the handler responds with
 // a 504 Gateway Timeout error and the given message in its body.
 // (If msg is empty, a suitable default message will be sent.)
 // After such a timeout, writes by h to its ResponseWriter will return
@@ -43,18 +49,22 @@ import (
 // https://golang.org/pkg/net/http/#Handler.
 //
 // The implementation is largely inspired by http.TimeoutHandler.
-func TimeToFirstByteTimeoutHandler(h http.Handler, dt time.Duration, msg string) http.Handler {
+func NewTimeoutHandler(h http.Handler, msg string, timeout, responseStartTimeout, idleTimeout time.Duration) http.Handler {
 	return &timeoutHandler{
-		handler: h,
-		body:    msg,
-		timeout: dt,
+		handler:              h,
+		body:                 msg,
+		timeout:              timeout,
+		responseStartTimeout: responseStartTimeout,
+		idleTimeout:          idleTimeout,
 	}
 }
 
 type timeoutHandler struct {
-	handler http.Handler
-	body    string
-	timeout time.Duration
+	handler              http.Handler
+	body                 string
+	timeout              time.Duration
+	responseStartTimeout time.Duration
+	idleTimeout          time.Duration
 }
 
 func (h *timeoutHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -67,7 +77,7 @@ func (h *timeoutHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	panicChan := make(chan interface{})
 	defer close(panicChan)
 
-	tw := &timeoutWriter{w: w}
+	tw := &timeoutWriter{w: w, lastWrite: time.Now()}
 	go func() {
 		// The defer statements are executed in LIFO order,
 		// so recover will execute first, then only, the channel will be closed.
@@ -82,16 +92,47 @@ func (h *timeoutHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	timeout := time.NewTimer(h.timeout)
 	defer timeout.Stop()
+	timeoutCh := timeout.C
+	var responseStartTimeoutCh, idleTimeoutCh <-chan time.Time
+	if h.responseStartTimeout > 0 {
+		responseStartTimeout := time.NewTimer(h.responseStartTimeout)
+		defer responseStartTimeout.Stop()
+		responseStartTimeoutCh = responseStartTimeout.C
+	}
+	var idleTimeout *time.Timer
+	if h.idleTimeout > 0 {
+		idleTimeout = time.NewTimer(h.idleTimeout)
+		defer idleTimeout.Stop()
+		idleTimeoutCh = idleTimeout.C
+	}
 	for {
 		select {
 		case p := <-panicChan:
 			panic(p)
 		case <-done:
 			return
-		case <-timeout.C:
+		case <-timeoutCh:
 			if tw.TimeoutAndWriteError(h.body) {
 				return
 			}
+			// The response already started, so the request timeout only
+			// bounds the time to first byte; let the stream finish.
+			timeoutCh = nil
+		case <-responseStartTimeoutCh:
+			if tw.TimeoutAndWriteError(h.body) {
+				return
+			}
+			// The response already started, so this timer is moot.
+			responseStartTimeoutCh = nil
+		case <-idleTimeoutCh:
+			if idle := time.Since(tw.lastWriteTime()); idle < h.idleTimeout {
+				// The writer has seen activity since the timer was set up,
+				// so wait out the remainder of the idle period.
+				idleTimeout.Reset(h.idleTimeout - idle)
+				continue
+			}
+			tw.CutOffAndWriteError(h.body)
+			return
 		}
 	}
 }
@@ -109,6 +150,7 @@ type timeoutWriter struct {
 	mu        sync.Mutex
 	timedOut  bool
 	wroteOnce bool
+	lastWrite time.Time
 }
 
 var _ http.Flusher = (*timeoutWriter)(nil)
@@ -116,6 +158,12 @@ var _ http.Flusher = (*timeoutWriter)(nil)
 var _ http.ResponseWriter = (*timeoutWriter)(nil)
 
 func (tw *timeoutWriter) Flush() {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return
+	}
+
 	tw.w.(http.Flusher).Flush()
 }
 
@@ -136,6 +184,7 @@ func (tw *timeoutWriter) Write(p []byte) (int, error) {
 	}
 
 	tw.wroteOnce = true
+	tw.lastWrite = time.Now()
 	return tw.w.Write(p)
 }
 
@@ -147,9 +196,18 @@ func (tw *timeoutWriter) WriteHeader(code int) {
 	}
 
 	tw.wroteOnce = true
+	tw.lastWrite = time.Now()
 	tw.w.WriteHeader(code)
 }
 
+// lastWriteTime returns the time of the last write to the underlying writer,
+// or the time the writer was created if nothing has been written yet.
+func (tw *timeoutWriter) lastWriteTime() time.Time {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	return tw.lastWrite
+}
+
 // TimeoutAndError writes an error to the response write if
 // nothing has been written on the writer before. Returns whether
 // an error was written or not.
@@ -170,3 +228,18 @@ func (tw *timeoutWriter) TimeoutAndWriteError(msg string) bool {
 
 	return false
 }
+
+// CutOffAndWriteError marks the writer as timed out even if the response
+// has already started, writing an error response if nothing has been
+// written before. All subsequent calls to Write will result in
+// http.ErrHandlerTimeout, cutting off in-flight streaming responses.
+func (tw *timeoutWriter) CutOffAndWriteError(msg string) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	if !tw.wroteOnce {
+		tw.w.WriteHeader(http.StatusGatewayTimeout)
+		io.WriteString(tw.w, msg)
+	}
+	tw.timedOut = true
+}
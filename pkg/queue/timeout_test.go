@@ -24,21 +24,24 @@ import (
 	"time"
 )
 
-func TestTimeToFirstByteTimeoutHandler(t *testing.T) {
+func TestTimeoutHandler(t *testing.T) {
 	const (
 		failingTimeout = 0 * time.Millisecond
+		shortTimeout   = 100 * time.Millisecond
 		longTimeout    = 10 * time.Second
 	)
 
 	tests := []struct {
-		name           string
-		timeout        time.Duration
-		handler        func(mux *sync.Mutex, writeErrors chan error) http.Handler
-		timeoutMessage string
-		wantStatus     int
-		wantBody       string
-		wantWriteError bool
-		wantPanic      bool
+		name                 string
+		timeout              time.Duration
+		responseStartTimeout time.Duration
+		idleTimeout          time.Duration
+		handler              func(mux *sync.Mutex, writeErrors chan error) http.Handler
+		timeoutMessage       string
+		wantStatus           int
+		wantBody             string
+		wantWriteError       bool
+		wantPanic            bool
 	}{{
 		name:    "all good",
 		timeout: longTimeout,
@@ -75,6 +78,78 @@ func TestTimeToFirstByteTimeoutHandler(t *testing.T) {
 		wantStatus: http.StatusGatewayTimeout,
 		wantBody:   "request timeout",
 		wantPanic:  true,
+	}, {
+		name:    "timeout moot after first byte",
+		timeout: shortTimeout,
+		handler: func(*sync.Mutex, chan error) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("hi"))
+				time.Sleep(2 * shortTimeout)
+				w.Write([]byte(" there"))
+			})
+		},
+		wantStatus: http.StatusOK,
+		wantBody:   "hi there",
+	}, {
+		name:                 "response start timeout",
+		timeout:              longTimeout,
+		responseStartTimeout: failingTimeout,
+		handler: func(mux *sync.Mutex, writeErrors chan error) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mux.Lock()
+				defer mux.Unlock()
+				_, werr := w.Write([]byte("hi"))
+				writeErrors <- werr
+			})
+		},
+		timeoutMessage: "response start timeout",
+		wantStatus:     http.StatusGatewayTimeout,
+		wantBody:       "response start timeout",
+		wantWriteError: true,
+	}, {
+		name:                 "response start timeout moot after first byte",
+		timeout:              longTimeout,
+		responseStartTimeout: shortTimeout,
+		handler: func(*sync.Mutex, chan error) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("hi"))
+				time.Sleep(2 * shortTimeout)
+				w.Write([]byte(" there"))
+			})
+		},
+		wantStatus: http.StatusOK,
+		wantBody:   "hi there",
+	}, {
+		name:        "idle timeout",
+		timeout:     longTimeout,
+		idleTimeout: shortTimeout,
+		handler: func(mux *sync.Mutex, writeErrors chan error) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("hi"))
+				time.Sleep(2 * shortTimeout)
+				_, werr := w.Write([]byte(" there"))
+				writeErrors <- werr
+			})
+		},
+		timeoutMessage: "idle timeout",
+		wantStatus:     http.StatusOK,
+		wantBody:       "hi",
+		wantWriteError: true,
+	}, {
+		name:        "idle timeout is reset by writes",
+		timeout:     longTimeout,
+		idleTimeout: 4 * shortTimeout,
+		handler: func(*sync.Mutex, chan error) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("hi"))
+				time.Sleep(2 * shortTimeout)
+				w.Write([]byte(" there"))
+				time.Sleep(2 * shortTimeout)
+				w.Write([]byte(" again"))
+			})
+		},
+		wantStatus: http.StatusOK,
+		wantBody:   "hi there again",
 	}}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -86,7 +161,8 @@ func TestTimeToFirstByteTimeoutHandler(t *testing.T) {
 			var reqMux sync.Mutex
 			writeErrors := make(chan error, 1)
 			rr := httptest.NewRecorder()
-			handler := TimeToFirstByteTimeoutHandler(test.handler(&reqMux, writeErrors), test.timeout, test.timeoutMessage)
+			handler := NewTimeoutHandler(test.handler(&reqMux, writeErrors), test.timeoutMessage,
+				test.timeout, test.responseStartTimeout, test.idleTimeout)
 
 			defer func() {
 				if test.wantPanic {
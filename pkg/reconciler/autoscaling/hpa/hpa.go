@@ -57,6 +57,10 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, pa *pav1alpha1.PodAutosc
 	pa.Status.InitializeConditions()
 	logger.Debug("PA exists")
 
+	// HPA-class PAs don't yet support scale-to-zero, so the initial scale
+	// is achieved as soon as the HPA brings the target up.
+	pa.Status.MarkScaleTargetInitialized()
+
 	// HPA-class PAs don't yet support scale-to-zero
 	pa.Status.MarkActive()
 
@@ -153,9 +153,11 @@ func TestReconcile(t *testing.T) {
 		},
 		WantStatusUpdates: []ktesting.UpdateActionImpl{{
 			Object: pa(testNamespace, testRevision, WithHPAClass, withScales(0, 0),
+				WithScaleTargetInitialized,
 				WithNoTraffic("ServicesNotReady", "SKS Services are not ready yet")),
 		}, {
 			Object: pa(testNamespace, testRevision, WithHPAClass, withScales(0, 0),
+				WithScaleTargetInitialized,
 				WithNoTraffic("ServicesNotReady", "SKS Services are not ready yet")),
 		}},
 	}, {
@@ -175,6 +177,7 @@ func TestReconcile(t *testing.T) {
 		},
 		WantStatusUpdates: []ktesting.UpdateActionImpl{{
 			Object: pa(testNamespace, testRevision, WithHPAClass, WithMetricAnnotation(autoscaling.Concurrency),
+				WithScaleTargetInitialized,
 				WithNoTraffic("ServicesNotReady", "SKS Services are not ready yet"), withScales(0, 0),
 				WithPAMetricsService(privateSvc)),
 		}},
@@ -194,6 +197,7 @@ func TestReconcile(t *testing.T) {
 		},
 		WantStatusUpdates: []ktesting.UpdateActionImpl{{
 			Object: pa(testNamespace, testRevision, WithHPAClass, WithMetricAnnotation(autoscaling.RPS),
+				WithScaleTargetInitialized,
 				WithNoTraffic("ServicesNotReady", "SKS Services are not ready yet"), withScales(0, 0),
 				WithPAMetricsService(privateSvc)),
 		}},
@@ -252,6 +256,7 @@ func TestReconcile(t *testing.T) {
 		},
 		WantStatusUpdates: []ktesting.UpdateActionImpl{{
 			Object: pa(testNamespace, testRevision, WithHPAClass, withScales(0, 0),
+				WithScaleTargetInitialized,
 				WithNoTraffic("ServicesNotReady", "SKS Services are not ready yet"),
 				WithPAStatusService(testRevision), WithPAMetricsService(privateSvc)),
 		}},
@@ -308,7 +313,8 @@ func TestReconcile(t *testing.T) {
 		Key:     key(testNamespace, testRevision),
 		WantErr: true,
 		WantStatusUpdates: []ktesting.UpdateActionImpl{{
-			Object: pa(testNamespace, testRevision, WithHPAClass, MarkResourceNotOwnedByPA("ServerlessService", testRevision)),
+			Object: pa(testNamespace, testRevision, WithHPAClass, WithScaleTargetInitialized,
+				MarkResourceNotOwnedByPA("ServerlessService", testRevision)),
 		}},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeWarning, "InternalError", `error reconciling SKS: PA: test-revision does not own SKS: test-revision`),
@@ -324,7 +330,8 @@ func TestReconcile(t *testing.T) {
 		Key:     key(testNamespace, testRevision),
 		WantErr: true,
 		WantStatusUpdates: []ktesting.UpdateActionImpl{{
-			Object: pa(testNamespace, testRevision, WithHPAClass, MarkResourceNotOwnedByPA("HorizontalPodAutoscaler", testRevision)),
+			Object: pa(testNamespace, testRevision, WithHPAClass, WithScaleTargetInitialized,
+				MarkResourceNotOwnedByPA("HorizontalPodAutoscaler", testRevision)),
 		}},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeWarning, "InternalError",
@@ -433,6 +440,7 @@ func TestReconcile(t *testing.T) {
 		},
 		WantStatusUpdates: []ktesting.UpdateActionImpl{{
 			Object: pa(testNamespace, testRevision, WithHPAClass, withScales(0, 0),
+				WithScaleTargetInitialized,
 				WithNoTraffic(
 					"FailedCreate", `Failed to create HorizontalPodAutoscaler "test-revision".`)),
 		}},
@@ -70,6 +70,17 @@ func MakeHPA(pa *v1alpha1.PodAutoscaler, config *autoscalerconfig.Config) *autos
 				},
 			}}
 		}
+	case autoscaling.Memory:
+		if target, ok := pa.Target(); ok {
+			memory := resource.NewQuantity(int64(target)*1024*1024, resource.BinarySI)
+			hpa.Spec.Metrics = []autoscalingv2beta1.MetricSpec{{
+				Type: autoscalingv2beta1.ResourceMetricSourceType,
+				Resource: &autoscalingv2beta1.ResourceMetricSource{
+					Name:               corev1.ResourceMemory,
+					TargetAverageValue: memory,
+				},
+			}}
+		}
 	case autoscaling.Concurrency, autoscaling.RPS:
 		t, _ := aresources.ResolveMetricTarget(pa, config)
 		target := int64(math.Ceil(t))
@@ -86,6 +97,19 @@ func MakeHPA(pa *v1alpha1.PodAutoscaler, config *autoscalerconfig.Config) *autos
 				TargetValue:  *resource.NewQuantity(target, resource.DecimalSI),
 			},
 		}}
+	default:
+		// Any other metric name is passed through to the custom metrics API,
+		// where a metric adapter (e.g. prometheus-adapter) is expected to
+		// serve it as a per-pod metric.
+		if target, ok := pa.Target(); ok {
+			hpa.Spec.Metrics = []autoscalingv2beta1.MetricSpec{{
+				Type: autoscalingv2beta1.PodsMetricSourceType,
+				Pods: &autoscalingv2beta1.PodsMetricSource{
+					MetricName:         pa.Metric(),
+					TargetAverageValue: *resource.NewQuantity(int64(math.Ceil(target)), resource.DecimalSI),
+				},
+			}}
+		}
 	}
 	return hpa
 }
@@ -85,6 +85,32 @@ func TestMakeHPA(t *testing.T) {
 					TargetAverageUtilization: ptr.Int32(1983),
 				},
 			})),
+	}, {
+		name: "with metric=memory and target=777",
+		pa:   pa(WithTargetAnnotation("777"), WithMetricAnnotation(autoscaling.Memory)),
+		want: hpa(
+			withAnnotationValue(autoscaling.MetricAnnotationKey, autoscaling.Memory),
+			withAnnotationValue(autoscaling.TargetAnnotationKey, "777"),
+			withMetric(autoscalingv2beta1.MetricSpec{
+				Type: autoscalingv2beta1.ResourceMetricSourceType,
+				Resource: &autoscalingv2beta1.ResourceMetricSource{
+					Name:               corev1.ResourceMemory,
+					TargetAverageValue: resource.NewQuantity(777*1024*1024, resource.BinarySI),
+				},
+			})),
+	}, {
+		name: "with a custom metric and target=10",
+		pa:   pa(WithTargetAnnotation("10"), WithMetricAnnotation("queue-depth")),
+		want: hpa(
+			withAnnotationValue(autoscaling.MetricAnnotationKey, "queue-depth"),
+			withAnnotationValue(autoscaling.TargetAnnotationKey, "10"),
+			withMetric(autoscalingv2beta1.MetricSpec{
+				Type: autoscalingv2beta1.PodsMetricSourceType,
+				Pods: &autoscalingv2beta1.PodsMetricSource{
+					MetricName:         "queue-depth",
+					TargetAverageValue: *resource.NewQuantity(10, resource.DecimalSI),
+				},
+			})),
 	}, {
 		name: "with metric=concurrency",
 		pa:   pa(WithMetricAnnotation(autoscaling.Concurrency)),
@@ -21,8 +21,6 @@ import (
 
 	"go.uber.org/zap"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
-	endpointsinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/endpoints"
-	podinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/pod"
 	serviceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/service"
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/logging"
@@ -31,6 +29,8 @@ import (
 	metricinformer "knative.dev/serving/pkg/client/injection/informers/autoscaling/v1alpha1/metric"
 	painformer "knative.dev/serving/pkg/client/injection/informers/autoscaling/v1alpha1/podautoscaler"
 	sksinformer "knative.dev/serving/pkg/client/injection/informers/networking/v1alpha1/serverlessservice"
+	endpointsinformer "knative.dev/serving/pkg/client/injection/kube/informers/filtered/core/v1/endpoints"
+	podinformer "knative.dev/serving/pkg/client/injection/kube/informers/filtered/core/v1/pod"
 	pareconciler "knative.dev/serving/pkg/client/injection/reconciler/autoscaling/v1alpha1/podautoscaler"
 
 	"k8s.io/client-go/tools/cache"
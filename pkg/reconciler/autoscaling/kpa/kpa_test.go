@@ -29,8 +29,8 @@ import (
 	// These are the fake informers we want setup.
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	fakekubeclient "knative.dev/pkg/client/injection/kube/client/fake"
-	fakeendpointsinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/endpoints/fake"
-	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/pod/fake"
+	fakeendpointsinformer "knative.dev/serving/pkg/client/injection/kube/informers/filtered/core/v1/endpoints/fake"
+	_ "knative.dev/serving/pkg/client/injection/kube/informers/filtered/core/v1/pod/fake"
 	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/service/fake"
 	fakedynamicclient "knative.dev/pkg/injection/clients/dynamicclient/fake"
 	"knative.dev/pkg/kmeta"
@@ -162,18 +162,23 @@ func markOld(pa *asv1a1.PodAutoscaler) {
 }
 
 func markActivating(pa *asv1a1.PodAutoscaler) {
+	pa.Status.InitializeConditions()
 	pa.Status.MarkActivating("Queued", "Requests to the target are being buffered as resources are provisioned.")
 }
 
 func markActive(pa *asv1a1.PodAutoscaler) {
+	pa.Status.InitializeConditions()
+	pa.Status.MarkScaleTargetInitialized()
 	pa.Status.MarkActive()
 }
 
 func markUnknown(pa *asv1a1.PodAutoscaler) {
+	pa.Status.InitializeConditions()
 	pa.Status.MarkActivating("", "")
 }
 
 func markInactive(pa *asv1a1.PodAutoscaler) {
+	pa.Status.InitializeConditions()
 	pa.Status.MarkInactive("NoTraffic", "The target is not receiving traffic.")
 }
 
@@ -245,10 +250,12 @@ func TestReconcile(t *testing.T) {
 			withMinScale(defaultScale), WithPAStatusService(testRevision), WithPAMetricsService(privateSvc),
 		)
 	}
-	activatingKPAMinScale := func(g int32) *asv1a1.PodAutoscaler {
+	activatingKPAMinScale := func(g int32, opts ...PodAutoscalerOption) *asv1a1.PodAutoscaler {
 		return kpa(
-			testNamespace, testRevision, markActivating, withScales(g, defaultScale), WithReachabilityReachable,
-			withMinScale(defaultScale), WithPAStatusService(testRevision), WithPAMetricsService(privateSvc),
+			testNamespace, testRevision, append([]PodAutoscalerOption{
+				markActivating, withScales(g, defaultScale), WithReachabilityReachable,
+				withMinScale(defaultScale), WithPAStatusService(testRevision), WithPAMetricsService(privateSvc),
+			}, opts...)...,
 		)
 	}
 	activeKPAMinScale := func(g, w int32) *asv1a1.PodAutoscaler {
@@ -476,7 +483,8 @@ func TestReconcile(t *testing.T) {
 			defaultDeployment, defaultEndpoints,
 		},
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
-			Object: kpa(testNamespace, testRevision, markActivating, withScales(0, defaultScale),
+			Object: kpa(testNamespace, testRevision, markActivating, WithScaleTargetInitialized,
+				withScales(0, defaultScale),
 				WithPAMetricsService(privateSvc), WithPAStatusService(testRevision)),
 		}},
 	}, {
@@ -573,7 +581,8 @@ func TestReconcile(t *testing.T) {
 		},
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
 			// SKS does not exist, so we're just creating and have no status.
-			Object: kpa(testNamespace, testRevision, markActivating, WithPAMetricsService(privateSvc), withScales(0, unknownScale)),
+			Object: kpa(testNamespace, testRevision, markActivating, WithScaleTargetInitialized,
+				WithPAMetricsService(privateSvc), withScales(0, unknownScale)),
 		}},
 		WantCreates: []runtime.Object{
 			sks(testNamespace, testRevision, WithDeployRef(deployName)),
@@ -589,7 +598,7 @@ func TestReconcile(t *testing.T) {
 		},
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
 			// SKS just got updated and we don't have up to date status.
-			Object: kpa(testNamespace, testRevision, markActivating,
+			Object: kpa(testNamespace, testRevision, markActivating, WithScaleTargetInitialized,
 				withScales(0, defaultScale), WithPAStatusService(testRevision), WithPAMetricsService(privateSvc)),
 		}},
 		WantUpdates: []clientgotesting.UpdateActionImpl{{
@@ -646,7 +655,7 @@ func TestReconcile(t *testing.T) {
 		},
 		WantErr: true,
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
-			Object: kpa(testNamespace, testRevision, withScales(1, defaultScale),
+			Object: kpa(testNamespace, testRevision, withScales(1, defaultScale), WithScaleTargetInitialized,
 				WithPAMetricsService(privateSvc), markResourceNotOwned("ServerlessService", testRevision)),
 		}},
 		WantEvents: []string{
@@ -663,7 +672,7 @@ func TestReconcile(t *testing.T) {
 		},
 		WantErr: true,
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
-			Object: kpa(testNamespace, testRevision, withScales(1, defaultScale),
+			Object: kpa(testNamespace, testRevision, withScales(1, defaultScale), WithScaleTargetInitialized,
 				WithPAMetricsService(privateSvc), markResourceNotOwned("Metric", testRevision)),
 		}},
 		WantEvents: []string{
@@ -721,7 +730,7 @@ func TestReconcile(t *testing.T) {
 		},
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
 			Object: kpa(testNamespace, testRevision, withScales(1, 0),
-				WithPAMetricsService(privateSvc),
+				WithPAMetricsService(privateSvc), WithScaleTargetInitialized,
 				WithNoTraffic("NoTraffic", "The target is not receiving traffic."),
 				WithPAStatusService(testRevision), WithPAMetricsService(privateSvc)),
 		}},
@@ -829,7 +838,7 @@ func TestReconcile(t *testing.T) {
 			minScalePatch,
 		},
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
-			Object: activatingKPAMinScale(underscale),
+			Object: activatingKPAMinScale(underscale, WithScaleTargetInitialized),
 		}},
 	}, {
 		// Scale to `minScale` and mark PA "active"
@@ -60,18 +60,34 @@ func MakeDecider(ctx context.Context, pa *v1alpha1.PodAutoscaler, config *autosc
 	if x, ok := pa.TargetBC(); ok {
 		tbc = x
 	}
+
+	maxScaleUpRate := config.MaxScaleUpRate
+	if x, ok := pa.MaxScaleUpRate(); ok {
+		maxScaleUpRate = x
+	}
+	maxScaleDownRate := config.MaxScaleDownRate
+	if x, ok := pa.MaxScaleDownRate(); ok {
+		maxScaleDownRate = x
+	}
+
+	scaleDownDelay := config.ScaleDownDelay
+	if x, ok := pa.ScaleDownDelay(); ok {
+		scaleDownDelay = x
+	}
 	return &scaling.Decider{
 		ObjectMeta: *pa.ObjectMeta.DeepCopy(),
 		Spec: scaling.DeciderSpec{
 			TickInterval:        config.TickInterval,
-			MaxScaleUpRate:      config.MaxScaleUpRate,
-			MaxScaleDownRate:    config.MaxScaleDownRate,
+			MaxScaleUpRate:      maxScaleUpRate,
+			MaxScaleDownRate:    maxScaleDownRate,
 			ScalingMetric:       pa.Metric(),
 			TargetValue:         target,
 			TotalValue:          total,
 			TargetBurstCapacity: tbc,
 			PanicThreshold:      panicThreshold,
 			StableWindow:        resources.StableWindow(pa, config),
+			ScaleDownDelay:      scaleDownDelay,
+			ActivationScale:     pa.ActivationScale(),
 			ServiceName:         svc,
 		},
 	}
@@ -118,6 +118,41 @@ func TestMakeDecider(t *testing.T) {
 			withService("rock-solid"),
 			withTarget(10.0), withPanicThreshold(40.0), withTotal(10.0),
 			withTargetAnnotation("10"), withPanicThresholdPercentageAnnotation("400")),
+	}, {
+		name: "with max scale up rate annotation",
+		pa:   pa(withMaxScaleUpRateAnnotation("100")),
+		want: decider(withTarget(100.0), withPanicThreshold(200.0), withTotal(100),
+			withScaleUpDownRates(100, config.MaxScaleDownRate),
+			withDeciderMaxScaleUpRateAnnotation("100")),
+	}, {
+		name: "with max scale down rate annotation",
+		pa:   pa(withMaxScaleDownRateAnnotation("4")),
+		want: decider(withTarget(100.0), withPanicThreshold(200.0), withTotal(100),
+			withScaleUpDownRates(config.MaxScaleUpRate, 4),
+			withDeciderMaxScaleDownRateAnnotation("4")),
+	}, {
+		name: "with scale down delay set in config",
+		pa:   pa(),
+		want: decider(withTarget(100.0), withPanicThreshold(200.0), withTotal(100),
+			withScaleDownDelay(5*time.Minute)),
+		cfgOpt: func(c autoscalerconfig.Config) *autoscalerconfig.Config {
+			c.ScaleDownDelay = 5 * time.Minute
+			return &c
+		},
+	}, {
+		name: "with scale down delay annotation",
+		pa:   pa(withScaleDownDelayAnnotation("10m")),
+		want: decider(withTarget(100.0), withPanicThreshold(200.0), withTotal(100),
+			withScaleDownDelay(10*time.Minute), withDeciderScaleDownDelayAnnotation("10m")),
+		cfgOpt: func(c autoscalerconfig.Config) *autoscalerconfig.Config {
+			c.ScaleDownDelay = 5 * time.Minute
+			return &c
+		},
+	}, {
+		name: "with activation scale annotation",
+		pa:   pa(withActivationScaleAnnotation("3")),
+		want: decider(withTarget(100.0), withPanicThreshold(200.0), withTotal(100),
+			withActivationScale(3), withDeciderActivationScaleAnnotation("3")),
 	}, {
 		name: "with metric annotation",
 		pa:   pa(WithMetricAnnotation("rps")),
@@ -171,6 +206,54 @@ func withDeciderTBCAnnotation(tbc string) deciderOption {
 	}
 }
 
+func withMaxScaleUpRateAnnotation(rate string) PodAutoscalerOption {
+	return func(pa *v1alpha1.PodAutoscaler) {
+		pa.Annotations[autoscaling.MaxScaleUpRateAnnotationKey] = rate
+	}
+}
+
+func withDeciderMaxScaleUpRateAnnotation(rate string) deciderOption {
+	return func(d *scaling.Decider) {
+		d.Annotations[autoscaling.MaxScaleUpRateAnnotationKey] = rate
+	}
+}
+
+func withActivationScaleAnnotation(scale string) PodAutoscalerOption {
+	return func(pa *v1alpha1.PodAutoscaler) {
+		pa.Annotations[autoscaling.ActivationScaleKey] = scale
+	}
+}
+
+func withDeciderActivationScaleAnnotation(scale string) deciderOption {
+	return func(d *scaling.Decider) {
+		d.Annotations[autoscaling.ActivationScaleKey] = scale
+	}
+}
+
+func withScaleDownDelayAnnotation(delay string) PodAutoscalerOption {
+	return func(pa *v1alpha1.PodAutoscaler) {
+		pa.Annotations[autoscaling.ScaleDownDelayAnnotationKey] = delay
+	}
+}
+
+func withDeciderScaleDownDelayAnnotation(delay string) deciderOption {
+	return func(d *scaling.Decider) {
+		d.Annotations[autoscaling.ScaleDownDelayAnnotationKey] = delay
+	}
+}
+
+func withMaxScaleDownRateAnnotation(rate string) PodAutoscalerOption {
+	return func(pa *v1alpha1.PodAutoscaler) {
+		pa.Annotations[autoscaling.MaxScaleDownRateAnnotationKey] = rate
+	}
+}
+
+func withDeciderMaxScaleDownRateAnnotation(rate string) deciderOption {
+	return func(d *scaling.Decider) {
+		d.Annotations[autoscaling.MaxScaleDownRateAnnotationKey] = rate
+	}
+}
+
 func decider(options ...deciderOption) *scaling.Decider {
 	m := &scaling.Decider{
 		ObjectMeta: metav1.ObjectMeta{
@@ -231,6 +314,18 @@ func withTarget(target float64) deciderOption {
 	}
 }
 
+func withActivationScale(s int32) deciderOption {
+	return func(decider *scaling.Decider) {
+		decider.Spec.ActivationScale = s
+	}
+}
+
+func withScaleDownDelay(d time.Duration) deciderOption {
+	return func(decider *scaling.Decider) {
+		decider.Spec.ScaleDownDelay = d
+	}
+}
+
 func withService(s string) deciderOption {
 	return func(d *scaling.Decider) {
 		d.Spec.ServiceName = s
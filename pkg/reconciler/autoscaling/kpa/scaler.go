@@ -29,6 +29,7 @@ import (
 	pkgnet "knative.dev/pkg/network"
 	"knative.dev/pkg/network/prober"
 	"knative.dev/serving/pkg/activator"
+	"knative.dev/serving/pkg/apis/autoscaling"
 	pav1alpha1 "knative.dev/serving/pkg/apis/autoscaling/v1alpha1"
 	"knative.dev/serving/pkg/apis/networking"
 	nv1a1 "knative.dev/serving/pkg/apis/networking/v1alpha1"
@@ -269,6 +270,27 @@ func (ks *scaler) Scale(ctx context.Context, pa *pav1alpha1.PodAutoscaler, sks *
 	}
 
 	min, max := pa.ScaleBounds()
+	if ws := pa.ScalingSchedule(); len(ws) > 0 {
+		// During a scheduled window the window's minimum scale acts as the
+		// lower bound, capped by maxScale. Re-enqueue the PA at the next
+		// window boundary so the bounds are re-evaluated in time.
+		sm, next := autoscaling.ScheduledMinScale(ws, time.Now())
+		if sm > min {
+			min = sm
+			if max != 0 && max < min {
+				min = max
+			}
+		}
+		ks.enqueueCB(pa, next)
+	}
+	initialScale := aresources.GetInitialScale(config.FromContext(ctx).Autoscaler, pa)
+	// Until the initial scale is attained, the initial scale acts as both the
+	// lower and, if need be, the upper bound, so that the revision starts at
+	// the requested size. Once attained, it's ignored altogether.
+	if initialScale > 1 && !pa.Status.IsScaleTargetInitialized() {
+		min = intMax(initialScale, min)
+		max = intMax(initialScale, max)
+	}
 	if newScale := applyBounds(min, max, desiredScale); newScale != desiredScale {
 		logger.Debugf("Adjusting desiredScale to meet the min and max bounds before applying: %d -> %d", desiredScale, newScale)
 		desiredScale = newScale
@@ -550,6 +550,7 @@ func newDeployment(t *testing.T, dynamicClient dynamic.Interface, name string, r
 }
 
 func paMarkActive(pa *pav1alpha1.PodAutoscaler, ltt time.Time) {
+	pa.Status.MarkScaleTargetInitialized()
 	pa.Status.MarkActive()
 
 	// This works because the conditions are sorted alphabetically
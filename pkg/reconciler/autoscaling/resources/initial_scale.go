@@ -0,0 +1,39 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strconv"
+
+	"knative.dev/serving/pkg/apis/autoscaling"
+	"knative.dev/serving/pkg/apis/autoscaling/v1alpha1"
+	asconfig "knative.dev/serving/pkg/autoscaler/config"
+)
+
+// GetInitialScale returns the calculated initial scale based on the autoscaler
+// config and initial scale annotation value.
+func GetInitialScale(asConfig *asconfig.Config, pa *v1alpha1.PodAutoscaler) int32 {
+	initialScale := asConfig.InitialScale
+	if s, ok := pa.Annotations[autoscaling.InitialScaleAnnotationKey]; ok {
+		// Ignore errors and no-op, if annotation is invalid.
+		// The value is validated in the webhook.
+		if is, err := strconv.ParseInt(s, 10, 32); err == nil {
+			initialScale = int32(is)
+		}
+	}
+	return initialScale
+}
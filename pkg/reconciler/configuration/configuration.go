@@ -111,7 +111,7 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, config *v1.Configuration
 	case rc.Status == corev1.ConditionFalse:
 		logger.Infof("Revision %q of configuration has failed", revName)
 		// TODO(mattmoor): Only emit the event the first time we see this.
-		config.Status.MarkLatestCreatedFailed(lcr.Name, rc.Message)
+		config.Status.MarkLatestCreatedFailed(lcr.Name, rc.Reason, rc.Message)
 		recorder.Eventf(config, corev1.EventTypeWarning, "LatestCreatedFailed",
 			"Latest created revision %q has failed", lcr.Name)
 
@@ -279,7 +279,7 @@ func TestReconcile(t *testing.T) {
 				WithLatestCreated("matching-revision"), WithObservedGen,
 				// When the LatestCreatedRevision reports back a failure,
 				// then we surface that failure.
-				MarkLatestCreatedFailed("It's the end of the world as we know it")),
+				MarkLatestCreatedFailed("ContainerMissing", "It's the end of the world as we know it")),
 		}},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeWarning, "LatestCreatedFailed", "Latest created revision %q has failed",
@@ -363,7 +363,7 @@ func TestReconcile(t *testing.T) {
 				WithLatestCreated("revision-recovers-00001"),
 				WithLatestReady("revision-recovers-00001"),
 				WithObservedGen,
-				MarkLatestCreatedFailed("Weebles wobble, but they don't fall down")),
+				MarkLatestCreatedFailed("RevisionFailed", "Weebles wobble, but they don't fall down")),
 			rev("revision-recovers", "foo", 1337,
 				WithCreationTimestamp(now),
 				WithRevName("revision-recovers-00001"),
@@ -28,6 +28,7 @@ import (
 	configurationinformer "knative.dev/serving/pkg/client/injection/informers/serving/v1/configuration"
 	revisioninformer "knative.dev/serving/pkg/client/injection/informers/serving/v1/revision"
 	configreconciler "knative.dev/serving/pkg/client/injection/reconciler/serving/v1/configuration"
+	"knative.dev/serving/pkg/leaderelection"
 )
 
 const controllerAgentName = "configuration-controller"
@@ -46,6 +47,7 @@ func NewController(
 		revisionLister: revisionInformer.Lister(),
 	}
 	impl := configreconciler.NewImpl(ctx, c)
+	leaderelection.GateReconciler(ctx, impl, configurationInformer.Informer())
 
 	logger.Info("Setting up event handlers")
 	configurationInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/logging"
+	"knative.dev/serving/pkg/network"
+)
+
+type cfgKey struct{}
+
+// Config holds the configuration the DomainMapping reconciler consumes.
+//
+// +k8s:deepcopy-gen=false
+type Config struct {
+	Network *network.Config
+}
+
+// FromContext extracts the Config from the given context.
+func FromContext(ctx context.Context) *Config {
+	return ctx.Value(cfgKey{}).(*Config)
+}
+
+// ToContext attaches the given Config to the given context.
+func ToContext(ctx context.Context, c *Config) context.Context {
+	return context.WithValue(ctx, cfgKey{}, c)
+}
+
+// Store is based on configmap.UntypedStore and is used to store and watch for
+// updates to configuration related to domain mappings (currently only
+// config-network).
+//
+// +k8s:deepcopy-gen=false
+type Store struct {
+	*configmap.UntypedStore
+}
+
+// NewStore creates a configmap.UntypedStore based config store.
+//
+// logger must be non-nil implementation of configmap.Logger (commonly used
+// loggers conform)
+//
+// onAfterStore is a variadic list of callbacks to run
+// after the ConfigMap has been processed and stored.
+//
+// See also: configmap.NewUntypedStore().
+func NewStore(ctx context.Context, onAfterStore ...func(name string, value interface{})) *Store {
+	logger := logging.FromContext(ctx)
+
+	store := &Store{
+		UntypedStore: configmap.NewUntypedStore(
+			"domainmapping",
+			logger,
+			configmap.Constructors{
+				network.ConfigName: network.NewConfigFromConfigMap,
+			},
+			onAfterStore...,
+		),
+	}
+
+	return store
+}
+
+// ToContext attaches the current state of the Store to the given context.
+func (s *Store) ToContext(ctx context.Context) context.Context {
+	return ToContext(ctx, s.Load())
+}
+
+// Load returns the current state of the Store.
+func (s *Store) Load() *Config {
+	return &Config{
+		Network: s.UntypedLoad(network.ConfigName).(*network.Config).DeepCopy(),
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domainmapping
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	pkgnetwork "knative.dev/pkg/network"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/resolver"
+	"knative.dev/serving/pkg/apis/networking"
+	netv1alpha1 "knative.dev/serving/pkg/apis/networking/v1alpha1"
+	"knative.dev/serving/pkg/apis/serving/v1alpha1"
+	clientset "knative.dev/serving/pkg/client/clientset/versioned"
+	domainmappingreconciler "knative.dev/serving/pkg/client/injection/reconciler/serving/v1alpha1/domainmapping"
+	netlisters "knative.dev/serving/pkg/client/listers/networking/v1alpha1"
+	"knative.dev/serving/pkg/reconciler/domainmapping/config"
+	"knative.dev/serving/pkg/reconciler/domainmapping/resources"
+)
+
+// Reconciler implements controller.Reconciler for DomainMapping resources.
+type Reconciler struct {
+	// client is used to create Ingresses.
+	client clientset.Interface
+
+	// ingressLister indexes the Ingresses owned by DomainMappings.
+	ingressLister netlisters.IngressLister
+
+	// resolver resolves the spec.ref to a cluster-local address.
+	resolver *resolver.URIResolver
+}
+
+// Check that our Reconciler implements Interface.
+var _ domainmappingreconciler.Interface = (*Reconciler)(nil)
+
+// ReconcileKind implements Interface.ReconcileKind.
+func (r *Reconciler) ReconcileKind(ctx context.Context, dm *v1alpha1.DomainMapping) pkgreconciler.Event {
+	logger := logging.FromContext(ctx)
+	logger.Debugf("Reconciling DomainMapping %s/%s", dm.Namespace, dm.Name)
+
+	// The mapped domain is the name of the DomainMapping.
+	url := &apis.URL{Scheme: "http", Host: dm.Name}
+	dm.Status.URL = url
+	dm.Status.Address = &duckv1.Addressable{URL: url}
+
+	targetHost, err := r.resolveRef(ctx, dm)
+	if err != nil {
+		dm.Status.MarkReferenceNotResolved(err.Error())
+		return fmt.Errorf("failed to resolve ref: %w", err)
+	}
+	dm.Status.MarkReferenceResolved()
+
+	// The cluster-local hostname is fronted by a K8s Service of the same
+	// name, which is the backend the Ingress forwards to.
+	backendService := strings.SplitN(targetHost, ".", 2)[0]
+	desired := resources.MakeIngress(dm, backendService, targetHost, ingressClassForDomainMapping(ctx, dm))
+	ingress, err := r.reconcileIngress(ctx, dm, desired)
+	if err != nil {
+		return err
+	}
+
+	if ingress.GetObjectMeta().GetGeneration() != ingress.Status.ObservedGeneration || !ingress.Status.IsReady() {
+		dm.Status.MarkIngressNotConfigured()
+	} else {
+		dm.Status.PropagateIngressStatus(ingress.Status)
+	}
+	return nil
+}
+
+// resolveRef resolves the Addressable the DomainMapping points at and checks
+// that it is a cluster-local hostname in the DomainMapping's namespace, which
+// is the only kind of target we know how to rewrite to.
+func (r *Reconciler) resolveRef(ctx context.Context, dm *v1alpha1.DomainMapping) (string, error) {
+	resolved, err := r.resolver.URIFromKReference(&dm.Spec.Ref, dm)
+	if err != nil {
+		return "", err
+	}
+	if resolved.Path != "" {
+		return "", fmt.Errorf("resolved URI %q contains a path", resolved)
+	}
+	suffix := "." + dm.Namespace + ".svc." + pkgnetwork.GetClusterDomainName()
+	if !strings.HasSuffix(resolved.Host, suffix) {
+		return "", fmt.Errorf("resolved URI %q must be of the form {name}%s", resolved, suffix)
+	}
+	return resolved.Host, nil
+}
+
+func (r *Reconciler) reconcileIngress(ctx context.Context, dm *v1alpha1.DomainMapping, desired *netv1alpha1.Ingress) (*netv1alpha1.Ingress, error) {
+	recorder := controller.GetEventRecorder(ctx)
+	ingress, err := r.ingressLister.Ingresses(desired.Namespace).Get(desired.Name)
+	if apierrs.IsNotFound(err) {
+		ingress, err = r.client.NetworkingV1alpha1().Ingresses(desired.Namespace).Create(desired)
+		if err != nil {
+			recorder.Eventf(dm, corev1.EventTypeWarning, "CreationFailed", "Failed to create Ingress: %v", err)
+			return nil, fmt.Errorf("failed to create Ingress: %w", err)
+		}
+
+		recorder.Eventf(dm, corev1.EventTypeNormal, "Created", "Created Ingress %q", ingress.GetName())
+		return ingress, nil
+	} else if err != nil {
+		return nil, err
+	} else if !equality.Semantic.DeepEqual(ingress.Spec, desired.Spec) ||
+		!equality.Semantic.DeepEqual(ingress.Annotations, desired.Annotations) {
+		// Don't modify the informers copy.
+		origin := ingress.DeepCopy()
+		origin.Spec = desired.Spec
+		origin.Annotations = desired.Annotations
+		updated, err := r.client.NetworkingV1alpha1().Ingresses(origin.Namespace).Update(origin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update Ingress: %w", err)
+		}
+		return updated, nil
+	}
+
+	return ingress, err
+}
+
+func ingressClassForDomainMapping(ctx context.Context, dm *v1alpha1.DomainMapping) string {
+	if ingressClass := dm.Annotations[networking.IngressClassAnnotationKey]; ingressClass != "" {
+		return ingressClass
+	}
+	return config.FromContext(ctx).Network.DefaultIngressClass
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"knative.dev/pkg/kmeta"
+	"knative.dev/serving/pkg/apis/networking"
+	netv1alpha1 "knative.dev/serving/pkg/apis/networking/v1alpha1"
+	"knative.dev/serving/pkg/apis/serving/v1alpha1"
+)
+
+// MakeIngress creates an Ingress that routes requests for the mapped domain
+// to the cluster-local hostname the DomainMapping's ref resolved to.  The
+// backend service is the K8s Service fronting that hostname, and the host
+// header is rewritten so the target accepts the request.
+func MakeIngress(dm *v1alpha1.DomainMapping, backendServiceName, hostName, ingressClass string) *netv1alpha1.Ingress {
+	return &netv1alpha1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dm.Name,
+			Namespace: dm.Namespace,
+			Annotations: map[string]string{
+				networking.IngressClassAnnotationKey: ingressClass,
+			},
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(dm)},
+		},
+		Spec: netv1alpha1.IngressSpec{
+			Rules: []netv1alpha1.IngressRule{{
+				Hosts:      []string{dm.Name},
+				Visibility: netv1alpha1.IngressVisibilityExternalIP,
+				HTTP: &netv1alpha1.HTTPIngressRuleValue{
+					Paths: []netv1alpha1.HTTPIngressPath{{
+						RewriteHost: hostName,
+						Splits: []netv1alpha1.IngressBackendSplit{{
+							Percent: 100,
+							IngressBackend: netv1alpha1.IngressBackend{
+								ServiceNamespace: dm.Namespace,
+								ServiceName:      backendServiceName,
+								ServicePort:      intstr.FromInt(80),
+							},
+						}},
+					}},
+				},
+			}},
+		},
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatewayapi
+
+import (
+	"context"
+
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection/clients/dynamicclient"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/reconciler"
+	"knative.dev/serving/pkg/apis/networking"
+	ingressinformer "knative.dev/serving/pkg/client/injection/informers/networking/v1alpha1/ingress"
+	ingressreconciler "knative.dev/serving/pkg/client/injection/reconciler/networking/v1alpha1/ingress"
+	"knative.dev/serving/pkg/network"
+)
+
+// NewController returns a new Ingress controller for the Gateway API.
+func NewController(
+	ctx context.Context,
+	cmw configmap.Watcher,
+) *controller.Impl {
+	logger := logging.FromContext(ctx)
+	ingressInformer := ingressinformer.Get(ctx)
+
+	c := &Reconciler{
+		dynamicclient: dynamicclient.Get(ctx),
+	}
+	filterFunc := reconciler.AnnotationFilterFunc(networking.IngressClassAnnotationKey, network.GatewayAPIIngressClassName, false)
+
+	impl := ingressreconciler.NewImpl(ctx, c, network.GatewayAPIIngressClassName)
+
+	logger.Info("Setting up Ingress event handlers")
+	ingressInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: filterFunc,
+		Handler:    controller.HandleAll(impl.Enqueue),
+	})
+
+	return impl
+}
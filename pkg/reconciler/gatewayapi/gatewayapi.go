@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+	"knative.dev/serving/pkg/apis/networking/v1alpha1"
+	ingressreconciler "knative.dev/serving/pkg/client/injection/reconciler/networking/v1alpha1/ingress"
+	"knative.dev/serving/pkg/reconciler/gatewayapi/resources"
+)
+
+const (
+	// KnativeGatewayName is the name of the Gateway API Gateway that
+	// serves Knative Ingresses, from whose status we derive the load
+	// balancer address.  It lives in the system namespace.
+	KnativeGatewayName = "knative-gateway"
+
+	notReconciledReason  = "ReconcileIngressFailed"
+	notReconciledMessage = "Ingress reconciliation failed"
+)
+
+// Reconciler implements controller.Reconciler for Ingress resources, by
+// translating them into Gateway API HTTPRoutes.
+type Reconciler struct {
+	// dynamicclient creates and updates HTTPRoutes, whose types are
+	// not vendored.
+	dynamicclient dynamic.Interface
+}
+
+// Check that our Reconciler implements Interface.
+var _ ingressreconciler.Interface = (*Reconciler)(nil)
+
+// ReconcileKind implements Interface.ReconcileKind.
+func (r *Reconciler) ReconcileKind(ctx context.Context, ing *v1alpha1.Ingress) pkgreconciler.Event {
+	logger := logging.FromContext(ctx)
+
+	if err := r.reconcileIngress(ctx, ing); err != nil {
+		logger.Errorw("Failed to reconcile Ingress", "error", err)
+		ing.Status.MarkIngressNotReady(notReconciledReason, notReconciledMessage)
+		return err
+	}
+	return nil
+}
+
+func (r *Reconciler) reconcileIngress(ctx context.Context, ing *v1alpha1.Ingress) error {
+	// We may be reading a version of the object that was stored at an older version
+	// and may not have had all of the assumed defaults specified.  This won't result
+	// in this getting written back to the API Server, but lets downstream logic make
+	// assumptions about defaulting.
+	ing.SetDefaults(ctx)
+
+	ing.Status.InitializeConditions()
+	ing.Status.ObservedGeneration = ing.GetGeneration()
+
+	for _, desired := range resources.MakeHTTPRoutes(ing) {
+		if err := r.reconcileHTTPRoute(ctx, ing, desired); err != nil {
+			ing.Status.MarkLoadBalancerFailed("HTTPRouteNotReconciled", err.Error())
+			return err
+		}
+	}
+	ing.Status.MarkNetworkConfigured()
+
+	lbs, err := r.gatewayLoadBalancers(ctx)
+	if err != nil {
+		return err
+	}
+	if len(lbs) == 0 {
+		ing.Status.MarkLoadBalancerNotReady()
+	} else {
+		ing.Status.MarkLoadBalancerReady(lbs, lbs, lbs)
+	}
+	return nil
+}
+
+func (r *Reconciler) reconcileHTTPRoute(ctx context.Context, ing *v1alpha1.Ingress, desired *unstructured.Unstructured) error {
+	recorder := controller.GetEventRecorder(ctx)
+	routes := r.dynamicclient.Resource(resources.HTTPRouteGVR).Namespace(desired.GetNamespace())
+
+	existing, err := routes.Get(desired.GetName(), metav1.GetOptions{})
+	if apierrs.IsNotFound(err) {
+		if _, err := routes.Create(desired, metav1.CreateOptions{}); err != nil {
+			recorder.Eventf(ing, corev1.EventTypeWarning, "CreationFailed", "Failed to create HTTPRoute: %v", err)
+			return fmt.Errorf("failed to create HTTPRoute: %w", err)
+		}
+		recorder.Eventf(ing, corev1.EventTypeNormal, "Created", "Created HTTPRoute %q", desired.GetName())
+		return nil
+	} else if err != nil {
+		return err
+	} else if !equality.Semantic.DeepEqual(existing.Object["spec"], desired.Object["spec"]) {
+		// Don't modify the informers copy.
+		origin := existing.DeepCopy()
+		origin.Object["spec"] = desired.Object["spec"]
+		if _, err := routes.Update(origin, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update HTTPRoute: %w", err)
+		}
+	}
+	return nil
+}
+
+// gatewayLoadBalancers derives the Ingress load balancer status from the
+// addresses the Knative Gateway reports.
+func (r *Reconciler) gatewayLoadBalancers(ctx context.Context) ([]v1alpha1.LoadBalancerIngressStatus, error) {
+	gateway, err := r.dynamicclient.Resource(resources.GatewayGVR).Namespace(system.Namespace()).
+		Get(KnativeGatewayName, metav1.GetOptions{})
+	if apierrs.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	addresses, _, err := unstructured.NestedSlice(gateway.Object, "status", "addresses")
+	if err != nil {
+		return nil, err
+	}
+	lbs := make([]v1alpha1.LoadBalancerIngressStatus, 0, len(addresses))
+	for _, address := range addresses {
+		a, ok := address.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		value, _, _ := unstructured.NestedString(a, "value")
+		if value == "" {
+			continue
+		}
+		switch t, _, _ := unstructured.NestedString(a, "type"); t {
+		case "NamedAddress":
+			lbs = append(lbs, v1alpha1.LoadBalancerIngressStatus{DomainInternal: value})
+		default:
+			// IPAddress is the default type.
+			lbs = append(lbs, v1alpha1.LoadBalancerIngressStatus{IP: value})
+		}
+	}
+	return lbs, nil
+}
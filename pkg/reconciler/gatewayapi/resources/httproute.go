@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/pkg/kmeta"
+	"knative.dev/serving/pkg/apis/networking"
+	"knative.dev/serving/pkg/apis/networking/v1alpha1"
+)
+
+// GatewayAPIGroupVersion is the GroupVersion of the Gateway API resources
+// we program.  The types are not vendored; we build them as unstructured
+// objects so that clusters without the Gateway API CRDs installed don't
+// drag in a new dependency.
+var GatewayAPIGroupVersion = schema.GroupVersion{Group: "networking.x-k8s.io", Version: "v1alpha1"}
+
+// HTTPRouteGVR is the GroupVersionResource of Gateway API HTTPRoutes.
+var HTTPRouteGVR = GatewayAPIGroupVersion.WithResource("httproutes")
+
+// GatewayGVR is the GroupVersionResource of Gateway API Gateways.
+var GatewayGVR = GatewayAPIGroupVersion.WithResource("gateways")
+
+// MakeHTTPRoutes creates one HTTPRoute per Ingress rule, translating the
+// hosts, splits and header manipulations of the rule.
+func MakeHTTPRoutes(ing *v1alpha1.Ingress) []*unstructured.Unstructured {
+	routes := make([]*unstructured.Unstructured, 0, len(ing.Spec.Rules))
+	for i, rule := range ing.Spec.Rules {
+		routes = append(routes, makeHTTPRoute(ing, i, &rule))
+	}
+	return routes
+}
+
+func makeHTTPRoute(ing *v1alpha1.Ingress, index int, rule *v1alpha1.IngressRule) *unstructured.Unstructured {
+	hostnames := make([]interface{}, 0, len(rule.Hosts))
+	for _, host := range rule.Hosts {
+		hostnames = append(hostnames, host)
+	}
+
+	rules := []interface{}{}
+	if rule.HTTP != nil {
+		for _, path := range rule.HTTP.Paths {
+			rules = append(rules, makeRule(path))
+		}
+	}
+
+	route := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": GatewayAPIGroupVersion.String(),
+			"kind":       "HTTPRoute",
+			"spec": map[string]interface{}{
+				// The Gateway selects the HTTPRoutes it serves, so allow all.
+				"gateways":  map[string]interface{}{"allow": "All"},
+				"hostnames": hostnames,
+				"rules":     rules,
+			},
+		},
+	}
+	route.SetName(HTTPRouteName(ing.Name, index))
+	route.SetNamespace(ing.Namespace)
+	route.SetLabels(map[string]string{
+		networking.IngressLabelKey: ing.Name,
+	})
+	route.SetOwnerReferences([]metav1.OwnerReference{*kmeta.NewControllerRef(ing)})
+	return route
+}
+
+func makeRule(path v1alpha1.HTTPIngressPath) map[string]interface{} {
+	match := map[string]interface{}{}
+	if path.Path != "" {
+		match["path"] = map[string]interface{}{
+			"type":  "Prefix",
+			"value": path.Path,
+		}
+	}
+	if len(path.Headers) > 0 {
+		values := map[string]interface{}{}
+		for name, m := range path.Headers {
+			values[name] = m.Exact
+		}
+		match["headers"] = map[string]interface{}{
+			"type":   "Exact",
+			"values": values,
+		}
+	}
+
+	forwardTo := make([]interface{}, 0, len(path.Splits))
+	for _, split := range path.Splits {
+		to := map[string]interface{}{
+			"serviceName": split.ServiceName,
+			"port":        int64(split.ServicePort.IntValue()),
+			"weight":      int64(split.Percent),
+		}
+		if len(split.AppendHeaders) > 0 {
+			to["filters"] = []interface{}{headerModifierFilter(split.AppendHeaders)}
+		}
+		forwardTo = append(forwardTo, to)
+	}
+
+	rule := map[string]interface{}{
+		"matches":   []interface{}{match},
+		"forwardTo": forwardTo,
+	}
+	if len(path.AppendHeaders) > 0 {
+		rule["filters"] = []interface{}{headerModifierFilter(path.AppendHeaders)}
+	}
+	return rule
+}
+
+func headerModifierFilter(headers map[string]string) map[string]interface{} {
+	add := map[string]interface{}{}
+	for name, value := range headers {
+		add[name] = value
+	}
+	return map[string]interface{}{
+		"type": "RequestHeaderModifier",
+		"requestHeaderModifier": map[string]interface{}{
+			"add": add,
+		},
+	}
+}
+
+// HTTPRouteName returns the name of the HTTPRoute for the given Ingress
+// rule index.
+func HTTPRouteName(ingressName string, index int) string {
+	return fmt.Sprintf("%s-%d", ingressName, index)
+}
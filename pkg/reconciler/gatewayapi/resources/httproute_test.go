@@ -0,0 +1,133 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/serving/pkg/apis/networking"
+	"knative.dev/serving/pkg/apis/networking/v1alpha1"
+)
+
+func TestMakeHTTPRoutes(t *testing.T) {
+	ing := &v1alpha1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-ingress",
+			Namespace: "test-ns",
+		},
+		Spec: v1alpha1.IngressSpec{
+			Rules: []v1alpha1.IngressRule{{
+				Hosts:      []string{"test-route.test-ns.example.com"},
+				Visibility: v1alpha1.IngressVisibilityExternalIP,
+				HTTP: &v1alpha1.HTTPIngressRuleValue{
+					Paths: []v1alpha1.HTTPIngressPath{{
+						Headers: map[string]v1alpha1.HeaderMatch{
+							"my-header": {Exact: "my-value"},
+						},
+						AppendHeaders: map[string]string{
+							"path-header": "path-value",
+						},
+						Splits: []v1alpha1.IngressBackendSplit{{
+							IngressBackend: v1alpha1.IngressBackend{
+								ServiceNamespace: "test-ns",
+								ServiceName:      "v1-service",
+								ServicePort:      intstr.FromInt(80),
+							},
+							Percent: 90,
+							AppendHeaders: map[string]string{
+								"split-header": "split-value",
+							},
+						}, {
+							IngressBackend: v1alpha1.IngressBackend{
+								ServiceNamespace: "test-ns",
+								ServiceName:      "v2-service",
+								ServicePort:      intstr.FromInt(80),
+							},
+							Percent: 10,
+						}},
+					}},
+				},
+			}},
+		},
+	}
+
+	got := MakeHTTPRoutes(ing)
+	if len(got) != 1 {
+		t.Fatalf("MakeHTTPRoutes() produced %d HTTPRoutes, want 1", len(got))
+	}
+
+	route := got[0]
+	if got, want := route.GetName(), "test-ingress-0"; got != want {
+		t.Errorf("GetName() = %q, want %q", got, want)
+	}
+	if got, want := route.GetNamespace(), "test-ns"; got != want {
+		t.Errorf("GetNamespace() = %q, want %q", got, want)
+	}
+	if got, want := route.GetLabels(), (map[string]string{networking.IngressLabelKey: "test-ingress"}); !cmp.Equal(want, got) {
+		t.Errorf("GetLabels() (-want, +got) = %v", cmp.Diff(want, got))
+	}
+	if got, want := route.GetOwnerReferences(), []metav1.OwnerReference{*kmeta.NewControllerRef(ing)}; !cmp.Equal(want, got) {
+		t.Errorf("GetOwnerReferences() (-want, +got) = %v", cmp.Diff(want, got))
+	}
+
+	wantSpec := map[string]interface{}{
+		"gateways":  map[string]interface{}{"allow": "All"},
+		"hostnames": []interface{}{"test-route.test-ns.example.com"},
+		"rules": []interface{}{map[string]interface{}{
+			"matches": []interface{}{map[string]interface{}{
+				"headers": map[string]interface{}{
+					"type": "Exact",
+					"values": map[string]interface{}{
+						"my-header": "my-value",
+					},
+				},
+			}},
+			"filters": []interface{}{map[string]interface{}{
+				"type": "RequestHeaderModifier",
+				"requestHeaderModifier": map[string]interface{}{
+					"add": map[string]interface{}{
+						"path-header": "path-value",
+					},
+				},
+			}},
+			"forwardTo": []interface{}{map[string]interface{}{
+				"serviceName": "v1-service",
+				"port":        int64(80),
+				"weight":      int64(90),
+				"filters": []interface{}{map[string]interface{}{
+					"type": "RequestHeaderModifier",
+					"requestHeaderModifier": map[string]interface{}{
+						"add": map[string]interface{}{
+							"split-header": "split-value",
+						},
+					},
+				}},
+			}, map[string]interface{}{
+				"serviceName": "v2-service",
+				"port":        int64(80),
+				"weight":      int64(10),
+			}},
+		}},
+	}
+	if got, want := route.Object["spec"], wantSpec; !cmp.Equal(want, got) {
+		t.Errorf("spec (-want, +got) = %v", cmp.Diff(want, got))
+	}
+}
@@ -19,6 +19,8 @@ package gc
 import (
 	"context"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -33,6 +35,7 @@ import (
 	clientset "knative.dev/serving/pkg/client/clientset/versioned"
 	configreconciler "knative.dev/serving/pkg/client/injection/reconciler/serving/v1/configuration"
 	listers "knative.dev/serving/pkg/client/listers/serving/v1"
+	gcconfig "knative.dev/serving/pkg/gc"
 	configns "knative.dev/serving/pkg/reconciler/gc/config"
 )
 
@@ -51,13 +54,22 @@ func (c *reconciler) ReconcileKind(ctx context.Context, config *v1.Configuration
 	cfg := configns.FromContext(ctx).RevisionGC
 	logger := logging.FromContext(ctx)
 
+	// When any of the retain settings is configured, the count-capped
+	// collection of non-active revisions applies instead of the legacy
+	// stale-revision behavior below.
+	if cfg.RetainSinceCreateTime != gcconfig.Disabled ||
+		cfg.RetainSinceLastActiveTime != gcconfig.Disabled ||
+		cfg.MaxNonActiveRevisions != gcconfig.Disabled {
+		return c.collectNonActive(ctx, config)
+	}
+
 	selector := labels.SelectorFromSet(labels.Set{serving.ConfigurationLabelKey: config.Name})
 	revs, err := c.revisionLister.Revisions(config.Namespace).List(selector)
 	if err != nil {
 		return err
 	}
 
-	gcSkipOffset := cfg.StaleRevisionMinimumGenerations
+	gcSkipOffset := retentionCount(logger, config, cfg.StaleRevisionMinimumGenerations)
 
 	if gcSkipOffset >= int64(len(revs)) {
 		return nil
@@ -80,8 +92,134 @@ func (c *reconciler) ReconcileKind(ctx context.Context, config *v1.Configuration
 	return nil
 }
 
+// collectNonActive deletes non-active Revisions of the Configuration per
+// the retain-since durations and the min/max count settings of config-gc.
+func (c *reconciler) collectNonActive(ctx context.Context, config *v1.Configuration) pkgreconciler.Event {
+	cfg := configns.FromContext(ctx).RevisionGC
+	logger := logging.FromContext(ctx)
+
+	selector := labels.SelectorFromSet(labels.Set{serving.ConfigurationLabelKey: config.Name})
+	revs, err := c.revisionLister.Revisions(config.Namespace).List(selector)
+	if err != nil {
+		return err
+	}
+
+	// The active Revisions and the ones explicitly exempt from collection
+	// are never candidates.
+	nonActive := make([]*v1.Revision, 0, len(revs))
+	for _, rev := range revs {
+		if !isRevisionActive(rev, config) && !isRevisionPreserved(rev) {
+			nonActive = append(nonActive, rev)
+		}
+	}
+	minNonActiveRevisions := retentionCount(logger, config, cfg.MinNonActiveRevisions)
+	count := int64(len(nonActive))
+	if count <= minNonActiveRevisions {
+		return nil
+	}
+
+	// Sort by last active time ascending, so the longest-inactive
+	// Revisions are deleted first.
+	sort.Slice(nonActive, func(i, j int) bool {
+		return revisionLastActive(nonActive[i]).Before(revisionLastActive(nonActive[j]))
+	})
+
+	// Trim above the maximum count first, regardless of the retain durations.
+	if cfg.MaxNonActiveRevisions != gcconfig.Disabled && count > cfg.MaxNonActiveRevisions {
+		needsDeleteCount := count - cfg.MaxNonActiveRevisions
+		logger.Infof("Maximum number of non-active revisions reached, deleting %d", needsDeleteCount)
+		for _, rev := range nonActive[:needsDeleteCount] {
+			c.deleteRevision(ctx, rev)
+		}
+		nonActive = nonActive[needsDeleteCount:]
+		count -= needsDeleteCount
+	}
+
+	// Then delete whatever is past both retain durations, oldest first,
+	// never dropping below the minimum count. Since the slice is sorted,
+	// the first retained Revision also retains all younger ones.
+	for _, rev := range nonActive {
+		if count <= minNonActiveRevisions || isRevisionRetained(cfg, rev) {
+			break
+		}
+		c.deleteRevision(ctx, rev)
+		count--
+	}
+	return nil
+}
+
+// isRevisionActive returns whether the Revision is (or is about to be)
+// routable and hence exempt from garbage collection. Besides the
+// Configuration's latest created and ready Revisions, this covers
+// Revisions pinned by a Route, per the route label and routes annotation
+// the labeler maintains.
+func isRevisionActive(rev *v1.Revision, config *v1.Configuration) bool {
+	if config.Status.LatestReadyRevisionName == rev.Name ||
+		config.Status.LatestCreatedRevisionName == rev.Name {
+		return true
+	}
+	return rev.GetLabels()[serving.RouteLabelKey] != "" ||
+		rev.GetAnnotations()[serving.RoutesAnnotationKey] != ""
+}
+
+// isRevisionPreserved returns whether the Revision opted out of garbage
+// collection via the no-gc annotation.
+func isRevisionPreserved(rev *v1.Revision) bool {
+	return strings.EqualFold(rev.GetAnnotations()[serving.RevisionNoGCAnnotationKey], "true")
+}
+
+// retentionCount returns the minimum number of non-active Revisions to
+// retain for the Configuration, honoring its retention-count annotation
+// over the given cluster default.
+func retentionCount(logger *zap.SugaredLogger, config *v1.Configuration, defaultCount int64) int64 {
+	raw, ok := config.GetAnnotations()[serving.RetentionCountAnnotationKey]
+	if !ok {
+		return defaultCount
+	}
+	val, err := strconv.ParseInt(raw, 10 /*base*/, 64 /*bit count*/)
+	if err != nil || val < 0 {
+		logger.Warnf("Ignoring invalid %s annotation %q on configuration %q",
+			serving.RetentionCountAnnotationKey, raw, config.Name)
+		return defaultCount
+	}
+	return val
+}
+
+// revisionLastActive returns the last time the Revision was known to be
+// pinned by a Route, falling back to the creation time for Revisions that
+// were never pinned.
+func revisionLastActive(rev *v1.Revision) time.Time {
+	if lastPin, err := rev.GetLastPinned(); err == nil {
+		return lastPin
+	}
+	return rev.ObjectMeta.CreationTimestamp.Time
+}
+
+// isRevisionRetained returns whether one of the retain durations still
+// protects the Revision from collection.
+func isRevisionRetained(cfg *gcconfig.Config, rev *v1.Revision) bool {
+	curTime := time.Now()
+	if cfg.RetainSinceCreateTime != gcconfig.Disabled &&
+		rev.ObjectMeta.CreationTimestamp.Add(cfg.RetainSinceCreateTime).After(curTime) {
+		return true
+	}
+	if cfg.RetainSinceLastActiveTime != gcconfig.Disabled &&
+		revisionLastActive(rev).Add(cfg.RetainSinceLastActiveTime).After(curTime) {
+		return true
+	}
+	return false
+}
+
+func (c *reconciler) deleteRevision(ctx context.Context, rev *v1.Revision) {
+	logger := logging.FromContext(ctx)
+	logger.Infof("Deleting non-active revision %q", rev.Name)
+	if err := c.client.ServingV1().Revisions(rev.Namespace).Delete(rev.Name, &metav1.DeleteOptions{}); err != nil {
+		logger.With(zap.Error(err)).Errorf("Failed to delete non-active revision %q", rev.Name)
+	}
+}
+
 func isRevisionStale(ctx context.Context, rev *v1.Revision, config *v1.Configuration) bool {
-	if config.Status.LatestReadyRevisionName == rev.Name {
+	if config.Status.LatestReadyRevisionName == rev.Name || isRevisionPreserved(rev) {
 		return false
 	}
 
@@ -33,6 +33,7 @@ import (
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/ptr"
 	pkgrec "knative.dev/pkg/reconciler"
+	"knative.dev/serving/pkg/apis/serving"
 	v1 "knative.dev/serving/pkg/apis/serving/v1"
 	servingclient "knative.dev/serving/pkg/client/injection/client/fake"
 	configreconciler "knative.dev/serving/pkg/client/injection/reconciler/serving/v1/configuration"
@@ -196,6 +197,228 @@ func TestGCReconcile(t *testing.T) {
 							StaleRevisionCreateDelay:        5 * time.Minute,
 							StaleRevisionTimeout:            5 * time.Minute,
 							StaleRevisionMinimumGenerations: 2,
+							RetainSinceCreateTime:           gcconfig.Disabled,
+							RetainSinceLastActiveTime:       gcconfig.Disabled,
+							MaxNonActiveRevisions:           gcconfig.Disabled,
+						},
+					},
+				}})
+	}))
+}
+
+func TestCollectNonActive(t *testing.T) {
+	now := time.Now()
+	elevenMinutesAgo := now.Add(-11 * time.Minute)
+	tenMinutesAgo := now.Add(-10 * time.Minute)
+	twoMinutesAgo := now.Add(-2 * time.Minute)
+	oneMinuteAgo := now.Add(-1 * time.Minute)
+
+	old := now.Add(-11 * time.Minute)
+	older := now.Add(-12 * time.Minute)
+	oldest := now.Add(-13 * time.Minute)
+
+	table := TableTest{{
+		Name: "delete longest-inactive revision past retain",
+		Objects: []runtime.Object{
+			cfg("past-retain", "foo", 5556,
+				WithLatestCreated("5556"),
+				WithLatestReady("5556"),
+				WithObservedGen),
+			rev("past-retain", "foo", 5554, MarkRevisionReady,
+				WithRevName("5554"),
+				WithCreationTimestamp(oldest),
+				WithLastPinned(tenMinutesAgo)),
+			rev("past-retain", "foo", 5555, MarkRevisionReady,
+				WithRevName("5555"),
+				WithCreationTimestamp(older),
+				// Still within the last-active retain duration, so kept.
+				WithLastPinned(now)),
+			rev("past-retain", "foo", 5556, MarkRevisionReady,
+				WithRevName("5556"),
+				WithCreationTimestamp(old),
+				WithLastPinned(tenMinutesAgo)),
+		},
+		WantDeletes: []clientgotesting.DeleteActionImpl{{
+			ActionImpl: clientgotesting.ActionImpl{
+				Namespace: "foo",
+				Verb:      "delete",
+				Resource: schema.GroupVersionResource{
+					Group:    "serving.knative.dev",
+					Version:  "v1",
+					Resource: "revisions",
+				},
+			},
+			Name: "5554",
+		}},
+		Key: "foo/past-retain",
+	}, {
+		Name: "keep minimum count of non-active revisions",
+		Objects: []runtime.Object{
+			cfg("keep-min", "foo", 5556,
+				WithLatestCreated("5556"),
+				WithLatestReady("5556"),
+				WithObservedGen),
+			// Past both retain durations, but the only non-active revision.
+			rev("keep-min", "foo", 5554, MarkRevisionReady,
+				WithRevName("5554"),
+				WithCreationTimestamp(oldest),
+				WithLastPinned(tenMinutesAgo)),
+			rev("keep-min", "foo", 5556, MarkRevisionReady,
+				WithRevName("5556"),
+				WithCreationTimestamp(old),
+				WithLastPinned(tenMinutesAgo)),
+		},
+		Key: "foo/keep-min",
+	}, {
+		Name: "maximum count trims regardless of retain durations",
+		Objects: []runtime.Object{
+			cfg("over-max", "foo", 5556,
+				WithLatestCreated("5556"),
+				WithLatestReady("5556"),
+				WithObservedGen),
+			// All three non-active revisions are still within the retain
+			// durations, but only two may remain.
+			rev("over-max", "foo", 5553, MarkRevisionReady,
+				WithRevName("5553"),
+				WithCreationTimestamp(oldest),
+				WithLastPinned(twoMinutesAgo)),
+			rev("over-max", "foo", 5554, MarkRevisionReady,
+				WithRevName("5554"),
+				WithCreationTimestamp(older),
+				WithLastPinned(oneMinuteAgo)),
+			rev("over-max", "foo", 5555, MarkRevisionReady,
+				WithRevName("5555"),
+				WithCreationTimestamp(old),
+				WithLastPinned(now)),
+			rev("over-max", "foo", 5556, MarkRevisionReady,
+				WithRevName("5556"),
+				WithCreationTimestamp(old),
+				WithLastPinned(now)),
+		},
+		WantDeletes: []clientgotesting.DeleteActionImpl{{
+			ActionImpl: clientgotesting.ActionImpl{
+				Namespace: "foo",
+				Verb:      "delete",
+				Resource: schema.GroupVersionResource{
+					Group:    "serving.knative.dev",
+					Version:  "v1",
+					Resource: "revisions",
+				},
+			},
+			Name: "5553",
+		}},
+		Key: "foo/over-max",
+	}, {
+		Name: "keep no-gc annotated revision",
+		Objects: []runtime.Object{
+			cfg("keep-no-gc", "foo", 5556,
+				WithLatestCreated("5556"),
+				WithLatestReady("5556"),
+				WithObservedGen),
+			// The oldest revision is past both retain durations, but
+			// explicitly exempt from collection.
+			rev("keep-no-gc", "foo", 5553, MarkRevisionReady,
+				WithRevName("5553"),
+				WithCreationTimestamp(oldest),
+				WithLastPinned(tenMinutesAgo),
+				WithRevisionAnn(serving.RevisionNoGCAnnotationKey, "true")),
+			rev("keep-no-gc", "foo", 5554, MarkRevisionReady,
+				WithRevName("5554"),
+				WithCreationTimestamp(older),
+				WithLastPinned(elevenMinutesAgo)),
+			rev("keep-no-gc", "foo", 5555, MarkRevisionReady,
+				WithRevName("5555"),
+				WithCreationTimestamp(old),
+				WithLastPinned(tenMinutesAgo)),
+			rev("keep-no-gc", "foo", 5556, MarkRevisionReady,
+				WithRevName("5556"),
+				WithCreationTimestamp(old),
+				WithLastPinned(now)),
+		},
+		WantDeletes: []clientgotesting.DeleteActionImpl{{
+			ActionImpl: clientgotesting.ActionImpl{
+				Namespace: "foo",
+				Verb:      "delete",
+				Resource: schema.GroupVersionResource{
+					Group:    "serving.knative.dev",
+					Version:  "v1",
+					Resource: "revisions",
+				},
+			},
+			Name: "5554",
+		}},
+		Key: "foo/keep-no-gc",
+	}, {
+		Name: "keep route-referenced revisions",
+		Objects: []runtime.Object{
+			cfg("keep-routed", "foo", 5556,
+				WithLatestCreated("5556"),
+				WithLatestReady("5556"),
+				WithObservedGen),
+			// The two oldest revisions are past the retain durations and over
+			// the maximum count, but Routes still reference them.
+			rev("keep-routed", "foo", 5553, MarkRevisionReady,
+				WithRevName("5553"),
+				WithCreationTimestamp(oldest),
+				WithLastPinned(tenMinutesAgo),
+				WithRevisionLabel(serving.RouteLabelKey, "my-route"),
+				WithRevisionAnn(serving.RoutesAnnotationKey, "my-route")),
+			rev("keep-routed", "foo", 5554, MarkRevisionReady,
+				WithRevName("5554"),
+				WithCreationTimestamp(older),
+				WithLastPinned(tenMinutesAgo),
+				WithRevisionAnn(serving.RoutesAnnotationKey, "my-route,my-other-route")),
+			rev("keep-routed", "foo", 5555, MarkRevisionReady,
+				WithRevName("5555"),
+				WithCreationTimestamp(old),
+				WithLastPinned(tenMinutesAgo)),
+			rev("keep-routed", "foo", 5556, MarkRevisionReady,
+				WithRevName("5556"),
+				WithCreationTimestamp(old),
+				WithLastPinned(now)),
+		},
+		Key: "foo/keep-routed",
+	}, {
+		Name: "retention-count annotation raises the minimum",
+		Objects: []runtime.Object{
+			cfg("keep-retention-count", "foo", 5556,
+				WithLatestCreated("5556"),
+				WithLatestReady("5556"),
+				WithObservedGen,
+				WithConfigAnn(serving.RetentionCountAnnotationKey, "2")),
+			// Both non-active revisions are past the retain durations, but
+			// the annotation retains two instead of the configured one.
+			rev("keep-retention-count", "foo", 5554, MarkRevisionReady,
+				WithRevName("5554"),
+				WithCreationTimestamp(oldest),
+				WithLastPinned(tenMinutesAgo)),
+			rev("keep-retention-count", "foo", 5555, MarkRevisionReady,
+				WithRevName("5555"),
+				WithCreationTimestamp(older),
+				WithLastPinned(tenMinutesAgo)),
+			rev("keep-retention-count", "foo", 5556, MarkRevisionReady,
+				WithRevName("5556"),
+				WithCreationTimestamp(old),
+				WithLastPinned(now)),
+		},
+		Key: "foo/keep-retention-count",
+	}}
+
+	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
+		r := &reconciler{
+			client:         servingclient.Get(ctx),
+			revisionLister: listers.GetRevisionLister(),
+		}
+		return configreconciler.NewReconciler(ctx, logging.FromContext(ctx),
+			servingclient.Get(ctx), listers.GetConfigurationLister(),
+			controller.GetEventRecorder(ctx), r, controller.Options{
+				ConfigStore: &testConfigStore{
+					config: &config.Config{
+						RevisionGC: &gcconfig.Config{
+							RetainSinceCreateTime:     5 * time.Minute,
+							RetainSinceLastActiveTime: 5 * time.Minute,
+							MinNonActiveRevisions:     1,
+							MaxNonActiveRevisions:     2,
 						},
 					},
 				}})
@@ -278,6 +501,19 @@ func TestIsRevisionStale(t *testing.T) {
 			},
 		},
 		want: false,
+	}, {
+		name: "stale revision with no-gc annotation",
+		rev: &v1.Revision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "myrev",
+				CreationTimestamp: metav1.NewTime(staleTime),
+				Annotations: map[string]string{
+					"serving.knative.dev/lastPinned": fmt.Sprintf("%d", staleTime.Unix()),
+					"serving.knative.dev/no-gc":      "true",
+				},
+			},
+		},
+		want: false,
 	}, {
 		name: "stale latest ready revision",
 		rev: &v1.Revision{
@@ -299,6 +535,9 @@ func TestIsRevisionStale(t *testing.T) {
 				StaleRevisionCreateDelay:        5 * time.Minute,
 				StaleRevisionTimeout:            5 * time.Minute,
 				StaleRevisionMinimumGenerations: 2,
+				RetainSinceCreateTime:           gcconfig.Disabled,
+				RetainSinceLastActiveTime:       gcconfig.Disabled,
+				MaxNonActiveRevisions:           gcconfig.Disabled,
 			},
 		},
 	}
@@ -24,6 +24,7 @@ import (
 	revisioninformer "knative.dev/serving/pkg/client/injection/informers/serving/v1/revision"
 	routeinformer "knative.dev/serving/pkg/client/injection/informers/serving/v1/route"
 	routereconciler "knative.dev/serving/pkg/client/injection/reconciler/serving/v1/route"
+	"knative.dev/serving/pkg/leaderelection"
 
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
@@ -51,6 +52,7 @@ func NewController(
 		revisionLister:      revisionInformer.Lister(),
 	}
 	impl := routereconciler.NewImpl(ctx, c)
+	leaderelection.GateReconciler(ctx, impl, routeInformer.Informer())
 
 	logger.Info("Setting up event handlers")
 	routeInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
@@ -30,6 +30,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	clientgotesting "k8s.io/client-go/testing"
 	routereconciler "knative.dev/serving/pkg/client/injection/reconciler/serving/v1/route"
 
@@ -66,13 +67,16 @@ func TestReconcile(t *testing.T) {
 		},
 		WantPatches: []clientgotesting.PatchActionImpl{
 			patchAddFinalizerAction("default", "first-reconcile"),
-			patchAddLabel("default", rev("default", "the-config").Name,
-				"serving.knative.dev/route", "first-reconcile"),
-			patchAddLabel("default", "the-config", "serving.knative.dev/route", "first-reconcile"),
+			patchAddRoutingMeta("default", rev("default", "the-config").Name,
+				"first-reconcile", "first-reconcile"),
+			patchAddRoutingMeta("default", "the-config", "first-reconcile", "first-reconcile"),
 		},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeNormal, "FinalizerUpdate", "Updated %q finalizers", "first-reconcile"),
 		},
+		PostConditions: []func(*testing.T, *TableRow){
+			AssertPatchType("the-config", types.MergePatchType),
+		},
 		Key: "default/first-reconcile",
 	}, {
 		Name: "steady state",
@@ -97,8 +101,8 @@ func TestReconcile(t *testing.T) {
 			rev("default", "the-config"),
 		},
 		WantPatches: []clientgotesting.PatchActionImpl{
-			patchAddLabel("default", rev("default", "the-config").Name,
-				"serving.knative.dev/route", "add-label-failure"),
+			patchAddRoutingMeta("default", rev("default", "the-config").Name,
+				"add-label-failure", "add-label-failure"),
 		},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeWarning, "InternalError",
@@ -119,7 +123,7 @@ func TestReconcile(t *testing.T) {
 				WithRevisionLabel("serving.knative.dev/route", "add-label-failure")),
 		},
 		WantPatches: []clientgotesting.PatchActionImpl{
-			patchAddLabel("default", "the-config", "serving.knative.dev/route", "add-label-failure"),
+			patchAddRoutingMeta("default", "the-config", "add-label-failure", "add-label-failure"),
 		},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeWarning, "InternalError",
@@ -127,8 +131,7 @@ func TestReconcile(t *testing.T) {
 		},
 		Key: "default/add-label-failure",
 	}, {
-		Name:    "label config with incorrect label",
-		WantErr: true,
+		Name: "second route is added to the routes annotation",
 		Objects: []runtime.Object{
 			simpleRunLatest("default", "the-route", "the-config", WithRouteFinalizer),
 			simpleConfig("default", "the-config",
@@ -136,11 +139,38 @@ func TestReconcile(t *testing.T) {
 			rev("default", "the-config",
 				WithRevisionLabel("serving.knative.dev/route", "another-route")),
 		},
-		WantEvents: []string{
-			Eventf(corev1.EventTypeWarning, "InternalError",
-				`/, Kind= "the-config-dbnfd" is already in use by "another-route", and cannot be used by "the-route"`),
+		WantPatches: []clientgotesting.PatchActionImpl{
+			// The label keeps its current owner; the annotation grows.
+			patchAddRoutingMeta("default", rev("default", "the-config").Name,
+				"another-route,the-route", "another-route"),
+			patchAddRoutingMeta("default", "the-config",
+				"another-route,the-route", "another-route"),
 		},
 		Key: "default/the-route",
+	}, {
+		Name: "remove one of two routes from the routes annotation",
+		Objects: []runtime.Object{
+			simpleRunLatest("default", "delete-one", "the-config",
+				WithRouteFinalizer, WithRouteDeletionTimestamp(&now)),
+			simpleConfig("default", "the-config",
+				WithConfigLabel("serving.knative.dev/route", "delete-one"),
+				WithConfigAnn("serving.knative.dev/routes", "delete-one,other-route")),
+			rev("default", "the-config",
+				WithRevisionLabel("serving.knative.dev/route", "delete-one"),
+				WithRevisionAnn("serving.knative.dev/routes", "delete-one,other-route")),
+		},
+		WantPatches: []clientgotesting.PatchActionImpl{
+			// The removed route held the label, so it is handed over to
+			// the remaining referencing route.
+			patchAddRoutingMeta("default", rev("default", "the-config").Name,
+				"other-route", "other-route"),
+			patchAddRoutingMeta("default", "the-config", "other-route", "other-route"),
+			patchRemoveFinalizerAction("default", "delete-one"),
+		},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "FinalizerUpdate", `Updated "delete-one" finalizers`),
+		},
+		Key: "default/delete-one",
 	}, {
 		Name: "change configurations",
 		Objects: []runtime.Object{
@@ -153,12 +183,11 @@ func TestReconcile(t *testing.T) {
 			rev("default", "new-config"),
 		},
 		WantPatches: []clientgotesting.PatchActionImpl{
-			patchRemoveLabel("default", rev("default", "old-config").Name,
-				"serving.knative.dev/route"),
-			patchAddLabel("default", rev("default", "new-config").Name,
-				"serving.knative.dev/route", "config-change"),
-			patchRemoveLabel("default", "old-config", "serving.knative.dev/route"),
-			patchAddLabel("default", "new-config", "serving.knative.dev/route", "config-change"),
+			patchRemoveRoutingMeta("default", rev("default", "old-config").Name),
+			patchAddRoutingMeta("default", rev("default", "new-config").Name,
+				"config-change", "config-change"),
+			patchRemoveRoutingMeta("default", "old-config"),
+			patchAddRoutingMeta("default", "new-config", "config-change", "config-change"),
 		},
 		Key: "default/config-change",
 	}, {
@@ -169,7 +198,7 @@ func TestReconcile(t *testing.T) {
 				WithConfigLabel("serving.knative.dev/route", "delete-route")),
 		},
 		WantPatches: []clientgotesting.PatchActionImpl{
-			patchRemoveLabel("default", "the-config", "serving.knative.dev/route"),
+			patchRemoveRoutingMeta("default", "the-config"),
 			patchRemoveFinalizerAction("default", "delete-route"),
 		},
 		WantEvents: []string{
@@ -194,7 +223,7 @@ func TestReconcile(t *testing.T) {
 			rev("default", "old-config"),
 		},
 		WantPatches: []clientgotesting.PatchActionImpl{
-			patchRemoveLabel("default", "old-config", "serving.knative.dev/route"),
+			patchRemoveRoutingMeta("default", "old-config"),
 		},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeWarning, "InternalError",
@@ -220,8 +249,7 @@ func TestReconcile(t *testing.T) {
 				WithRevisionLabel("serving.knative.dev/route", "delete-label-failure")),
 		},
 		WantPatches: []clientgotesting.PatchActionImpl{
-			patchRemoveLabel("default", rev("default", "old-config").Name,
-				"serving.knative.dev/route"),
+			patchRemoveRoutingMeta("default", rev("default", "old-config").Name),
 		},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeWarning, "InternalError",
@@ -288,23 +316,24 @@ func rev(namespace, name string, opts ...RevisionOption) *v1.Revision {
 	return rev
 }
 
-func patchRemoveLabel(namespace, name, key string) clientgotesting.PatchActionImpl {
+func patchRemoveRoutingMeta(namespace, name string) clientgotesting.PatchActionImpl {
 	action := clientgotesting.PatchActionImpl{}
 	action.Name = name
 	action.Namespace = namespace
 
-	patch := fmt.Sprintf(`{"metadata":{"labels":{%q:null}}}`, key)
+	patch := `{"metadata":{"annotations":{"serving.knative.dev/routes":null},"labels":{"serving.knative.dev/route":null}}}`
 
 	action.Patch = []byte(patch)
 	return action
 }
 
-func patchAddLabel(namespace, name, key, value string) clientgotesting.PatchActionImpl {
+func patchAddRoutingMeta(namespace, name, routes, label string) clientgotesting.PatchActionImpl {
 	action := clientgotesting.PatchActionImpl{}
 	action.Name = name
 	action.Namespace = namespace
 
-	patch := fmt.Sprintf(`{"metadata":{"labels":{%q:%q}}}`, key, value)
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q},"labels":{%q:%q}}}`,
+		"serving.knative.dev/routes", routes, "serving.knative.dev/route", label)
 
 	action.Patch = []byte(patch)
 	return action
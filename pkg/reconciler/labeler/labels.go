@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -40,7 +41,8 @@ type accessor interface {
 }
 
 // syncLabels makes sure that the revisions and configurations referenced from
-// a Route are labeled with route labels.
+// a Route are labeled with route labels and list the Route in their routes
+// annotation.
 func (c *Reconciler) syncLabels(ctx context.Context, r *v1.Route) error {
 	revisions := sets.NewString()
 	configs := sets.NewString()
@@ -86,34 +88,39 @@ func (c *Reconciler) clearLabels(ctx context.Context, ns, name string) error {
 	return deleteLabelForNotListed(ctx, ns, name, cacc, sets.NewString())
 }
 
-// setLabelForListed uses the accessor to attach the label for this route to every element
-// listed within "names" in the same namespace.
+// setLabelForListed uses the accessor to attach the label and the routes
+// annotation for this route to every element listed within "names" in the
+// same namespace.
 func setLabelForListed(ctx context.Context, route *v1.Route, acc accessor, names sets.String) error {
 	for name := range names {
 		elt, err := acc.get(route.Namespace, name)
 		if err != nil {
 			return err
 		}
-		routeName, ok := elt.GetLabels()[serving.RouteLabelKey]
-		if ok {
-			if routeName != route.Name {
-				return fmt.Errorf("%s %q is already in use by %q, and cannot be used by %q",
-					elt.GroupVersionKind(), elt.GetName(), routeName, route.Name)
-			}
-		} else {
-			if err := setRouteLabel(acc, elt, &route.Name); err != nil {
-				return fmt.Errorf("failed to add route label to %s %q: %w",
-					elt.GroupVersionKind(), elt.GetName(), err)
-			}
+		routeName, hasLabel := elt.GetLabels()[serving.RouteLabelKey]
+		routes := routeNameSet(elt)
+		if hasLabel && routes.Has(route.Name) {
+			continue
+		}
+		// The label keeps its current owner, so an element referenced by
+		// several Routes stays stable; the annotation tracks the full set.
+		if !hasLabel {
+			routeName = route.Name
+		}
+		routes.Insert(route.Name)
+		if err := setRouteMeta(acc, elt, &routeName, routes); err != nil {
+			return fmt.Errorf("failed to add route label to %s %q: %w",
+				elt.GroupVersionKind(), elt.GetName(), err)
 		}
 	}
 
 	return nil
 }
 
-// deleteLabelForNotListed uses the accessor to delete the label from any listable entity that is
-// not named within our list.  Unlike setLabelForListed, this function takes ns/name instead of a
-// Route so that it can clean things up when a Route ceases to exist.
+// deleteLabelForNotListed uses the accessor to delete the label and the routes
+// annotation entry from any listable entity that is not named within our list.
+// Unlike setLabelForListed, this function takes ns/name instead of a Route so
+// that it can clean things up when a Route ceases to exist.
 func deleteLabelForNotListed(ctx context.Context, ns, name string, acc accessor, names sets.String) error {
 	oldList, err := acc.list(ns, name)
 	if err != nil {
@@ -126,7 +133,15 @@ func deleteLabelForNotListed(ctx context.Context, ns, name string, acc accessor,
 			continue
 		}
 
-		if err := setRouteLabel(acc, elt, nil); err != nil {
+		routes := routeNameSet(elt)
+		routes.Delete(name)
+		var routeName *string
+		if routes.Len() > 0 {
+			// The removed Route may have held the label; hand it over to
+			// one of the remaining referencing Routes.
+			routeName = &routes.List()[0]
+		}
+		if err := setRouteMeta(acc, elt, routeName, routes); err != nil {
 			return fmt.Errorf("failed to remove route label to %s %q: %w",
 				elt.GroupVersionKind(), elt.GetName(), err)
 		}
@@ -135,12 +150,32 @@ func deleteLabelForNotListed(ctx context.Context, ns, name string, acc accessor,
 	return nil
 }
 
-// setRouteLabel toggles the route label on the specified element through the provided accessor.
-// a nil route name will cause the route label to be deleted, and a non-nil route will cause
-// that route name to be attached to the element.
-func setRouteLabel(acc accessor, elt kmeta.Accessor, routeName *string) error {
+// routeNameSet returns the set of Routes referencing the element, parsed from
+// the comma-separated routes annotation and falling back to the route label
+// for elements last written before the annotation existed.
+func routeNameSet(elt kmeta.Accessor) sets.String {
+	routes := sets.NewString()
+	if ann := elt.GetAnnotations()[serving.RoutesAnnotationKey]; ann != "" {
+		routes.Insert(strings.Split(ann, ",")...)
+	} else if label := elt.GetLabels()[serving.RouteLabelKey]; label != "" {
+		routes.Insert(label)
+	}
+	return routes
+}
+
+// setRouteMeta sets the route label and the routes annotation on the specified
+// element through the provided accessor. A nil route name with an empty set
+// will cause both to be deleted.
+func setRouteMeta(acc accessor, elt kmeta.Accessor, routeName *string, routes sets.String) error {
+	var routesValue interface{}
+	if routes.Len() > 0 {
+		routesValue = strings.Join(routes.List(), ",")
+	}
 	mergePatch := map[string]interface{}{
 		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				serving.RoutesAnnotationKey: routesValue,
+			},
 			"labels": map[string]interface{}{
 				serving.RouteLabelKey: routeName,
 			},
@@ -168,18 +203,19 @@ func (r *revision) get(ns, name string) (kmeta.Accessor, error) {
 	return r.r.revisionLister.Revisions(ns).Get(name)
 }
 
-// list implements accessor
+// list implements accessor. The routes annotation is not selectable, so this
+// lists all revisions in the namespace and filters on the parsed route set.
 func (r *revision) list(ns, name string) ([]kmeta.Accessor, error) {
-	rl, err := r.r.revisionLister.Revisions(ns).List(labels.SelectorFromSet(labels.Set{
-		serving.RouteLabelKey: name,
-	}))
+	rl, err := r.r.revisionLister.Revisions(ns).List(labels.Everything())
 	if err != nil {
 		return nil, err
 	}
 	// Need a copy to change types in Go
 	kl := make([]kmeta.Accessor, 0, len(rl))
 	for _, r := range rl {
-		kl = append(kl, r)
+		if routeNameSet(r).Has(name) {
+			kl = append(kl, r)
+		}
 	}
 	return kl, err
 }
@@ -203,18 +239,19 @@ func (c *configuration) get(ns, name string) (kmeta.Accessor, error) {
 	return c.r.configurationLister.Configurations(ns).Get(name)
 }
 
-// list implements accessor
+// list implements accessor. The routes annotation is not selectable, so this
+// lists all configurations in the namespace and filters on the parsed route set.
 func (c *configuration) list(ns, name string) ([]kmeta.Accessor, error) {
-	rl, err := c.r.configurationLister.Configurations(ns).List(labels.SelectorFromSet(labels.Set{
-		serving.RouteLabelKey: name,
-	}))
+	rl, err := c.r.configurationLister.Configurations(ns).List(labels.Everything())
 	if err != nil {
 		return nil, err
 	}
 	// Need a copy to change types in Go
 	kl := make([]kmeta.Accessor, 0, len(rl))
 	for _, r := range rl {
-		kl = append(kl, r)
+		if routeNameSet(r).Has(name) {
+			kl = append(kl, r)
+		}
 	}
 	return kl, err
 }
@@ -0,0 +1,188 @@
+/*
+Copyright 2020 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/google/go-containerregistry/pkg/authn/k8schain"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	v1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+const (
+	// digestResolutionWorkers is the number of digest resolutions that may
+	// happen in parallel across all Revisions.
+	digestResolutionWorkers = 100
+
+	// digestResolutionRetries is the number of times a failed resolution is
+	// retried with exponential backoff before its error is surfaced on the
+	// Revision.
+	digestResolutionRetries = 3
+)
+
+// imageResolver is the interface the background resolver uses to perform the
+// actual tag-to-digest lookups.
+type imageResolver interface {
+	Resolve(image string, opt k8schain.Options, registriesToSkip sets.String) (string, error)
+}
+
+// backgroundResolver performs tag-to-digest resolution asynchronously in a
+// dedicated worker pool, so that a slow or unresponsive registry cannot stall
+// the reconciler workqueue for other Revisions.
+type backgroundResolver struct {
+	logger *zap.SugaredLogger
+
+	resolver imageResolver
+	enqueue  func(types.NamespacedName)
+
+	queue workqueue.RateLimitingInterface
+
+	mu      sync.Mutex
+	results map[types.NamespacedName]*resolveResult
+}
+
+// resolveResult is the result of a digest resolution for a single Revision.
+type resolveResult struct {
+	// These fields are immutable after creation, so they may be read without
+	// holding the resolver's mutex.
+	image            string
+	opt              k8schain.Options
+	registriesToSkip sets.String
+
+	// These fields may only be accessed while holding the resolver's mutex.
+	digest string
+	err    error
+	ready  bool
+}
+
+func newBackgroundResolver(logger *zap.SugaredLogger, resolver imageResolver, enqueue func(types.NamespacedName)) *backgroundResolver {
+	return &backgroundResolver{
+		logger:   logger,
+		resolver: resolver,
+		enqueue:  enqueue,
+		queue: workqueue.NewNamedRateLimitingQueue(
+			workqueue.NewItemExponentialFailureRateLimiter(time.Second, 30*time.Second),
+			"digest-resolution"),
+		results: make(map[types.NamespacedName]*resolveResult),
+	}
+}
+
+// Start starts maxInFlight workers processing the resolution queue, and shuts
+// them down once the stop channel is closed. The returned channel is closed
+// after all workers have exited.
+func (r *backgroundResolver) Start(stopCh <-chan struct{}, maxInFlight int) chan struct{} {
+	var wg sync.WaitGroup
+	wg.Add(maxInFlight)
+	for i := 0; i < maxInFlight; i++ {
+		go func() {
+			defer wg.Done()
+			for {
+				name, shutdown := r.queue.Get()
+				if shutdown {
+					return
+				}
+				r.processWorkItem(name.(types.NamespacedName))
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	go func() {
+		<-stopCh
+		r.queue.ShutDown()
+	}()
+	return done
+}
+
+// Resolve is intended to be called from the Revision reconciler. If no
+// resolution for the Revision is in flight it starts one and reports
+// ready == false; the Revision is re-enqueued via the enqueue callback once
+// the resolution completes. Otherwise it reports the current state of the
+// resolution: ready == false while the workers are still resolving, and the
+// resolved digest or the resolution error once they are done.
+func (r *backgroundResolver) Resolve(rev *v1.Revision, opt k8schain.Options, registriesToSkip sets.String) (string, bool, error) {
+	name := types.NamespacedName{Namespace: rev.Namespace, Name: rev.Name}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result, inFlight := r.results[name]
+	if !inFlight {
+		r.results[name] = &resolveResult{
+			image:            rev.Spec.GetContainer().Image,
+			opt:              opt,
+			registriesToSkip: registriesToSkip,
+		}
+		r.queue.Add(name)
+		return "", false, nil
+	}
+	if !result.ready {
+		return "", false, nil
+	}
+	return result.digest, true, result.err
+}
+
+// Clear removes the cached resolution state for the given Revision. The
+// reconciler calls this once the resolved digest has been written to the
+// Revision's status, and on resolution errors so that a later reconciliation
+// retries the resolution rather than being stuck with the cached error.
+func (r *backgroundResolver) Clear(name types.NamespacedName) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.results, name)
+}
+
+func (r *backgroundResolver) processWorkItem(name types.NamespacedName) {
+	defer r.queue.Done(name)
+
+	r.mu.Lock()
+	result := r.results[name]
+	r.mu.Unlock()
+
+	// The result may have been cleared while the work item sat in the queue.
+	if result == nil {
+		r.queue.Forget(name)
+		return
+	}
+
+	digest, err := r.resolver.Resolve(result.image, result.opt, result.registriesToSkip)
+	if err != nil && r.queue.NumRequeues(name) < digestResolutionRetries {
+		r.logger.Errorw("Retrying digest resolution for revision "+name.String(), zap.Error(err))
+		r.queue.AddRateLimited(name)
+		return
+	}
+	r.queue.Forget(name)
+
+	r.mu.Lock()
+	result.digest = digest
+	result.err = err
+	result.ready = true
+	r.mu.Unlock()
+
+	r.enqueue(name)
+}
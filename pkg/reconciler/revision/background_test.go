@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn/k8schain"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	logtesting "knative.dev/pkg/logging/testing"
+	v1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+type countingResolver struct {
+	digest string
+	err    error
+	calls  int32
+}
+
+func (r *countingResolver) Resolve(_ string, _ k8schain.Options, _ sets.String) (string, error) {
+	atomic.AddInt32(&r.calls, 1)
+	return r.digest, r.err
+}
+
+func backgroundTestSetup(t *testing.T, inner imageResolver) (*backgroundResolver, chan types.NamespacedName, func()) {
+	enqueued := make(chan types.NamespacedName, 10)
+	r := newBackgroundResolver(logtesting.TestLogger(t), inner, func(name types.NamespacedName) {
+		enqueued <- name
+	})
+	// Back off quickly so that retries don't slow the test down.
+	r.queue = workqueue.NewNamedRateLimitingQueue(
+		workqueue.NewItemExponentialFailureRateLimiter(time.Millisecond, 10*time.Millisecond),
+		"digest-resolution-test")
+
+	stopCh := make(chan struct{})
+	done := r.Start(stopCh, 2)
+	return r, enqueued, func() {
+		close(stopCh)
+		<-done
+	}
+}
+
+func backgroundTestRevision() *v1.Revision {
+	rev := &v1.Revision{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "background-resolve",
+		},
+	}
+	rev.Spec.Containers = []corev1.Container{{
+		Name:  "user-container",
+		Image: "ubuntu:latest",
+	}}
+	return rev
+}
+
+func TestBackgroundResolve(t *testing.T) {
+	inner := &countingResolver{digest: "ubuntu@sha256:deadbeef"}
+	r, enqueued, cancel := backgroundTestSetup(t, inner)
+	defer cancel()
+
+	rev := backgroundTestRevision()
+	name := types.NamespacedName{Namespace: rev.Namespace, Name: rev.Name}
+
+	if digest, ready, err := r.Resolve(rev, k8schain.Options{}, sets.NewString()); digest != "" || ready || err != nil {
+		t.Errorf("Resolve() = (%q, %v, %v), want in flight", digest, ready, err)
+	}
+
+	select {
+	case got := <-enqueued:
+		if got != name {
+			t.Errorf("Enqueued %v, want %v", got, name)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the revision to be enqueued")
+	}
+
+	if digest, ready, err := r.Resolve(rev, k8schain.Options{}, sets.NewString()); digest != inner.digest || !ready || err != nil {
+		t.Errorf("Resolve() = (%q, %v, %v), want (%q, true, nil)", digest, ready, err, inner.digest)
+	}
+
+	// The cached result is returned without another resolution.
+	if got, want := atomic.LoadInt32(&inner.calls), int32(1); got != want {
+		t.Errorf("Resolution attempts = %d, want %d", got, want)
+	}
+}
+
+func TestBackgroundResolveRetriesBeforeSurfacingError(t *testing.T) {
+	inner := &countingResolver{err: errors.New("tag resolution failed, oh noes")}
+	r, enqueued, cancel := backgroundTestSetup(t, inner)
+	defer cancel()
+
+	rev := backgroundTestRevision()
+
+	if _, ready, err := r.Resolve(rev, k8schain.Options{}, sets.NewString()); ready || err != nil {
+		t.Errorf("Resolve() = (_, %v, %v), want in flight", ready, err)
+	}
+
+	select {
+	case <-enqueued:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the revision to be enqueued")
+	}
+
+	if _, ready, err := r.Resolve(rev, k8schain.Options{}, sets.NewString()); !ready || err == nil {
+		t.Errorf("Resolve() = (_, %v, %v), want the resolution error", ready, err)
+	}
+
+	// The error is only surfaced once the retries are exhausted.
+	if got, want := atomic.LoadInt32(&inner.calls), int32(digestResolutionRetries+1); got != want {
+		t.Errorf("Resolution attempts = %d, want %d", got, want)
+	}
+
+	// Clearing the result causes a subsequent Resolve to start over.
+	r.Clear(types.NamespacedName{Namespace: rev.Namespace, Name: rev.Name})
+	if _, ready, _ := r.Resolve(rev, k8schain.Options{}, sets.NewString()); ready {
+		t.Error("Resolve() after Clear() = ready, want a fresh resolution in flight")
+	}
+}
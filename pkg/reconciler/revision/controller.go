@@ -23,12 +23,12 @@ import (
 	cachingclient "knative.dev/caching/pkg/client/injection/client"
 	imageinformer "knative.dev/caching/pkg/client/injection/informers/caching/v1alpha1/image"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
-	deploymentinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment"
 	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap"
 	serviceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/service"
 	servingclient "knative.dev/serving/pkg/client/injection/client"
 	painformer "knative.dev/serving/pkg/client/injection/informers/autoscaling/v1alpha1/podautoscaler"
 	revisioninformer "knative.dev/serving/pkg/client/injection/informers/serving/v1/revision"
+	deploymentinformer "knative.dev/serving/pkg/client/injection/kube/informers/filtered/apps/v1/deployment"
 	revisionreconciler "knative.dev/serving/pkg/client/injection/reconciler/serving/v1/revision"
 
 	"k8s.io/client-go/tools/cache"
@@ -39,6 +39,7 @@ import (
 	apisconfig "knative.dev/serving/pkg/apis/config"
 	v1 "knative.dev/serving/pkg/apis/serving/v1"
 	"knative.dev/serving/pkg/deployment"
+	"knative.dev/serving/pkg/leaderelection"
 	"knative.dev/serving/pkg/network"
 	"knative.dev/serving/pkg/reconciler/revision/config"
 )
@@ -87,10 +88,6 @@ func newControllerWithOptions(
 		imageLister:         imageInformer.Lister(),
 		deploymentLister:    deploymentInformer.Lister(),
 		serviceLister:       serviceInformer.Lister(),
-		resolver: &digestResolver{
-			client:    kubeclient.Get(ctx),
-			transport: transport,
-		},
 	}
 	impl := revisionreconciler.NewImpl(ctx, c, func(impl *controller.Impl) controller.Options {
 		configsToResync := []interface{}{
@@ -110,6 +107,16 @@ func newControllerWithOptions(
 		configStore.WatchConfigs(cmw)
 		return controller.Options{ConfigStore: configStore}
 	})
+	leaderelection.GateReconciler(ctx, impl, revisionInformer.Informer())
+
+	// Resolve image digests in a background worker pool, so that a slow
+	// registry doesn't block the reconciler workqueue for other Revisions.
+	resolver := newBackgroundResolver(logger, &digestResolver{
+		client:    kubeclient.Get(ctx),
+		transport: transport,
+	}, impl.EnqueueKey)
+	resolver.Start(ctx.Done(), digestResolutionWorkers)
+	c.resolver = resolver
 
 	// Set up an event handler for when the resource types of interest change
 	logger.Info("Setting up event handlers")
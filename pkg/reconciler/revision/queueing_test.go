@@ -26,6 +26,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
@@ -50,10 +51,12 @@ import (
 
 type nopResolver struct{}
 
-func (r *nopResolver) Resolve(_ string, _ k8schain.Options, _ sets.String) (string, error) {
-	return "", nil
+func (r *nopResolver) Resolve(_ *v1.Revision, _ k8schain.Options, _ sets.String) (string, bool, error) {
+	return "", true, nil
 }
 
+func (r *nopResolver) Clear(types.NamespacedName) {}
+
 const (
 	testAutoscalerImage = "autoscalerImage"
 	testNamespace       = "test"
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/logging/logkey"
 	v1 "knative.dev/serving/pkg/apis/serving/v1"
@@ -33,6 +34,18 @@ import (
 	resourcenames "knative.dev/serving/pkg/reconciler/revision/resources/names"
 )
 
+// containerFailureReasons is the set of container waiting reasons that are
+// terminal enough to surface on the Revision without waiting for the
+// deployment's progress deadline to expire.
+var containerFailureReasons = sets.NewString(
+	"ImagePullBackOff",
+	"ErrImagePull",
+	"InvalidImageName",
+	"CrashLoopBackOff",
+	"CreateContainerConfigError",
+	"CreateContainerError",
+)
+
 func (c *Reconciler) reconcileDeployment(ctx context.Context, rev *v1.Revision) error {
 	ns := rev.Namespace
 	deploymentName := resourcenames.Deployment(rev)
@@ -94,11 +107,17 @@ func (c *Reconciler) reconcileDeployment(ctx context.Context, rev *v1.Revision)
 			for _, status := range pod.Status.ContainerStatuses {
 				if status.Name == rev.Spec.GetContainer().Name {
 					if t := status.LastTerminationState.Terminated; t != nil {
-						logger.Infof("%s marking exiting with: %d/%s", rev.Name, t.ExitCode, t.Message)
-						rev.Status.MarkContainerHealthyFalse(v1.ExitCodeReason(t.ExitCode), v1.RevisionContainerExitingMessage(t.Message))
-					} else if w := status.State.Waiting; w != nil && hasDeploymentTimedOut(deployment) {
-						logger.Infof("%s marking resources unavailable with: %s: %s", rev.Name, w.Reason, w.Message)
-						rev.Status.MarkResourcesAvailableFalse(w.Reason, w.Message)
+						message := t.Message
+						if message == "" {
+							// Terminations like OOMKilled carry their diagnostics in the
+							// reason rather than the message.
+							message = t.Reason
+						}
+						logger.Infof("%s marking exiting with: %d/%s", rev.Name, t.ExitCode, message)
+						rev.Status.MarkContainerHealthyFalse(v1.ExitCodeReason(t.ExitCode), v1.RevisionContainerExitingMessage(message))
+					} else if w := status.State.Waiting; w != nil && (containerFailureReasons.Has(w.Reason) || hasDeploymentTimedOut(deployment)) {
+						logger.Infof("%s marking container unhealthy with: %s: %s", rev.Name, w.Reason, w.Message)
+						rev.Status.MarkContainerHealthyFalse(w.Reason, w.Message)
 					}
 					break
 				}
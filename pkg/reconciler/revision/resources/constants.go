@@ -32,7 +32,8 @@ const (
 	AppLabelKey = "app"
 
 	// ProgressDeadlineSeconds is the time in seconds we wait for the deployment to
-	// be ready before considering it failed.
+	// be ready before considering it failed, unless overridden via the
+	// progressDeadline setting in config-deployment.
 	ProgressDeadlineSeconds = int32(120)
 )
 
@@ -19,6 +19,7 @@ package resources
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/logging"
@@ -188,6 +189,14 @@ func makePodSpec(rev *v1.Revision, loggingConfig *logging.Config, tracingConfig
 		ServiceAccountName:            rev.Spec.ServiceAccountName,
 		TerminationGracePeriodSeconds: rev.Spec.TimeoutSeconds,
 		ImagePullSecrets:              rev.Spec.ImagePullSecrets,
+		// Scheduling fields are gated by the webhook, so anything that made
+		// it onto the Revision is safe to hand to the Deployment verbatim.
+		Affinity:                  rev.Spec.Affinity,
+		NodeSelector:              rev.Spec.NodeSelector,
+		Tolerations:               rev.Spec.Tolerations,
+		TopologySpreadConstraints: rev.Spec.TopologySpreadConstraints,
+		RuntimeClassName:          rev.Spec.RuntimeClassName,
+		PriorityClassName:         rev.Spec.PriorityClassName,
 	}
 
 	// Add the Knative internal volume only if /var/log collection is enabled
@@ -252,11 +261,47 @@ func MakeDeployment(rev *v1.Revision,
 			podTemplateAnnotations[IstioOutboundIPRangeAnnotation] = networkConfig.IstioOutboundIPRanges
 		}
 	}
+
+	// Add the extra pod labels and annotations from config-deployment, without
+	// overriding what the Revision itself specifies.
+	podTemplateLabels := makeLabels(rev)
+	for k, v := range deploymentConfig.PodLabels {
+		if _, ok := podTemplateLabels[k]; !ok {
+			podTemplateLabels[k] = v
+		}
+	}
+	for k, v := range deploymentConfig.PodAnnotations {
+		if _, ok := podTemplateAnnotations[k]; !ok {
+			podTemplateAnnotations[k] = v
+		}
+	}
+
+	progressDeadline := ProgressDeadlineSeconds
+	if deploymentConfig.ProgressDeadline > 0 {
+		progressDeadline = int32(deploymentConfig.ProgressDeadline.Seconds())
+	}
+	// The progress-deadline annotation on the Revision takes precedence over
+	// the config-deployment setting.
+	if pd, ok := rev.Annotations[serving.ProgressDeadlineAnnotationKey]; ok {
+		// The annotation is validated in the webhook.
+		if d, err := time.ParseDuration(pd); err == nil {
+			progressDeadline = int32(d.Seconds())
+		}
+	}
+
 	podSpec, err := makePodSpec(rev, loggingConfig, tracingConfig, observabilityConfig, autoscalerConfig, deploymentConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create PodSpec: %w", err)
 	}
 
+	replicaCount := autoscalerConfig.InitialScale
+	if ann, found := rev.Annotations[autoscaling.InitialScaleAnnotationKey]; found {
+		// The annotation is validated in the webhook.
+		if rc, err := strconv.ParseInt(ann, 10, 32); err == nil {
+			replicaCount = int32(rc)
+		}
+	}
+
 	return &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      names.Deployment(rev),
@@ -269,12 +314,12 @@ func MakeDeployment(rev *v1.Revision,
 			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(rev)},
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas:                ptr.Int32(1),
+			Replicas:                ptr.Int32(replicaCount),
 			Selector:                makeSelector(rev),
-			ProgressDeadlineSeconds: ptr.Int32(ProgressDeadlineSeconds),
+			ProgressDeadlineSeconds: ptr.Int32(progressDeadline),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels:      makeLabels(rev),
+					Labels:      podTemplateLabels,
 					Annotations: podTemplateAnnotations,
 				},
 				Spec: *podSpec,
@@ -19,6 +19,7 @@ package resources
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -34,6 +35,7 @@ import (
 	"knative.dev/pkg/system"
 	_ "knative.dev/pkg/system/testing"
 	tracingconfig "knative.dev/pkg/tracing/config"
+	"knative.dev/serving/pkg/apis/autoscaling"
 	"knative.dev/serving/pkg/apis/networking"
 	"knative.dev/serving/pkg/apis/serving"
 	v1 "knative.dev/serving/pkg/apis/serving/v1"
@@ -71,7 +73,7 @@ var (
 
 	defaultQueueContainer = &corev1.Container{
 		Name:      QueueContainerName,
-		Resources: createQueueResources(make(map[string]string), &corev1.Container{}),
+		Resources: createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 		Ports:     append(queueNonServingPorts, queueHTTPPort),
 		ReadinessProbe: &corev1.Probe{
 			Handler: corev1.Handler{
@@ -104,6 +106,15 @@ var (
 		}, {
 			Name:  "REVISION_TIMEOUT_SECONDS",
 			Value: "45",
+		}, {
+			Name:  "REVISION_RESPONSE_START_TIMEOUT_SECONDS",
+			Value: "0",
+		}, {
+			Name:  "REVISION_IDLE_TIMEOUT_SECONDS",
+			Value: "0",
+		}, {
+			Name:  "DRAIN_TIMEOUT_SECONDS",
+			Value: "0",
 		}, {
 			Name: "SERVING_POD",
 			ValueFrom: &corev1.EnvVarSource{
@@ -141,9 +152,18 @@ var (
 		}, {
 			Name:  "TRACING_CONFIG_SAMPLE_RATE",
 			Value: "0.000000",
+		}, {
+			Name:  "CONCURRENCY_STATE_ENDPOINT",
+			Value: "",
+		}, {
+			Name:  "REJECTION_POLICY",
+			Value: "",
 		}, {
 			Name:  "USER_PORT",
 			Value: "8080",
+		}, {
+			Name:  "USER_PORT_PROTOCOL",
+			Value: "http1",
 		}, {
 			Name:  "SYSTEM_NAMESPACE",
 			Value: system.Namespace(),
@@ -814,6 +834,65 @@ func TestMakePodSpec(t *testing.T) {
 					withEnvVar("SERVING_SERVICE", ""),
 				),
 			}),
+	}, {
+		name: "with scheduling fields",
+		rev: revision(
+			withContainerConcurrency(1),
+			func(revision *v1.Revision) {
+				revision.Spec.Affinity = &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{},
+				}
+				revision.Spec.NodeSelector = map[string]string{
+					"kubernetes.io/arch": "amd64",
+				}
+				revision.Spec.Tolerations = []corev1.Toleration{{
+					Key:      "the-taint",
+					Operator: corev1.TolerationOpExists,
+				}}
+				revision.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{{
+					MaxSkew:           1,
+					TopologyKey:       "topology.kubernetes.io/zone",
+					WhenUnsatisfiable: corev1.ScheduleAnyway,
+				}}
+				revision.Spec.RuntimeClassName = ptr.String("gvisor")
+				revision.Spec.PriorityClassName = "high-priority"
+				container(revision.Spec.GetContainer(),
+					withTCPReadinessProbe(),
+				)
+			},
+		),
+		lc: &logging.Config{},
+		tc: &tracingconfig.Config{},
+		oc: &metrics.ObservabilityConfig{},
+		ac: &autoscalerconfig.Config{},
+		cc: &deployment.Config{},
+		want: podSpec(
+			[]corev1.Container{
+				userContainer(),
+				queueContainer(
+					withEnvVar("CONTAINER_CONCURRENCY", "1"),
+				),
+			},
+			func(podSpec *corev1.PodSpec) {
+				podSpec.Affinity = &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{},
+				}
+				podSpec.NodeSelector = map[string]string{
+					"kubernetes.io/arch": "amd64",
+				}
+				podSpec.Tolerations = []corev1.Toleration{{
+					Key:      "the-taint",
+					Operator: corev1.TolerationOpExists,
+				}}
+				podSpec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{{
+					MaxSkew:           1,
+					TopologyKey:       "topology.kubernetes.io/zone",
+					WhenUnsatisfiable: corev1.ScheduleAnyway,
+				}}
+				podSpec.RuntimeClassName = ptr.String("gvisor")
+				podSpec.PriorityClassName = "high-priority"
+			},
+		),
 	}}
 
 	for _, test := range tests {
@@ -892,7 +971,7 @@ func TestMakeDeployment(t *testing.T) {
 		tc:   &tracingconfig.Config{},
 		nc:   &network.Config{},
 		oc:   &metrics.ObservabilityConfig{},
-		ac:   &autoscalerconfig.Config{},
+		ac:   &autoscalerconfig.Config{InitialScale: 1},
 		cc:   &deployment.Config{},
 		want: makeDeployment(),
 	}, {
@@ -915,7 +994,7 @@ func TestMakeDeployment(t *testing.T) {
 		tc:   &tracingconfig.Config{},
 		nc:   &network.Config{},
 		oc:   &metrics.ObservabilityConfig{},
-		ac:   &autoscalerconfig.Config{},
+		ac:   &autoscalerconfig.Config{InitialScale: 1},
 		cc:   &deployment.Config{},
 		want: makeDeployment(),
 	}, {
@@ -939,7 +1018,7 @@ func TestMakeDeployment(t *testing.T) {
 			IstioOutboundIPRanges: "*",
 		},
 		oc: &metrics.ObservabilityConfig{},
-		ac: &autoscalerconfig.Config{},
+		ac: &autoscalerconfig.Config{InitialScale: 1},
 		cc: &deployment.Config{},
 		want: makeDeployment(func(deploy *appsv1.Deployment) {
 			deploy.Spec.Template.ObjectMeta.Annotations[IstioOutboundIPRangeAnnotation] = "*"
@@ -963,7 +1042,7 @@ func TestMakeDeployment(t *testing.T) {
 		tc: &tracingconfig.Config{},
 		nc: &network.Config{},
 		oc: &metrics.ObservabilityConfig{},
-		ac: &autoscalerconfig.Config{},
+		ac: &autoscalerconfig.Config{InitialScale: 1},
 		cc: &deployment.Config{},
 		want: makeDeployment(func(deploy *appsv1.Deployment) {
 			deploy.ObjectMeta.Annotations[sidecarIstioInjectAnnotation] = "false"
@@ -993,12 +1072,132 @@ func TestMakeDeployment(t *testing.T) {
 			IstioOutboundIPRanges: "*",
 		},
 		oc: &metrics.ObservabilityConfig{},
-		ac: &autoscalerconfig.Config{},
+		ac: &autoscalerconfig.Config{InitialScale: 1},
 		cc: &deployment.Config{},
 		want: makeDeployment(func(deploy *appsv1.Deployment) {
 			deploy.ObjectMeta.Annotations[IstioOutboundIPRangeAnnotation] = "10.4.0.0/14,10.7.240.0/20"
 			deploy.Spec.Template.ObjectMeta.Annotations[IstioOutboundIPRangeAnnotation] = "10.4.0.0/14,10.7.240.0/20"
 		}),
+	}, {
+		name: "with cluster initial scale",
+		rev: revision(
+			withoutLabels,
+			func(revision *v1.Revision) {
+				container(revision.Spec.GetContainer(),
+					withReadinessProbe(corev1.Handler{
+						TCPSocket: &corev1.TCPSocketAction{
+							Host: "127.0.0.1",
+							Port: intstr.FromInt(12345),
+						},
+					}),
+				)
+			},
+		),
+		lc: &logging.Config{},
+		tc: &tracingconfig.Config{},
+		nc: &network.Config{},
+		oc: &metrics.ObservabilityConfig{},
+		ac: &autoscalerconfig.Config{InitialScale: 3},
+		cc: &deployment.Config{},
+		want: makeDeployment(func(deploy *appsv1.Deployment) {
+			deploy.Spec.Replicas = ptr.Int32(3)
+		}),
+	}, {
+		name: "with initial scale annotation override",
+		rev: revision(
+			withoutLabels,
+			func(revision *v1.Revision) {
+				revision.ObjectMeta.Annotations = map[string]string{
+					autoscaling.InitialScaleAnnotationKey: "4",
+				}
+				container(revision.Spec.GetContainer(),
+					withReadinessProbe(corev1.Handler{
+						TCPSocket: &corev1.TCPSocketAction{
+							Host: "127.0.0.1",
+							Port: intstr.FromInt(12345),
+						},
+					}),
+				)
+			},
+		),
+		lc: &logging.Config{},
+		tc: &tracingconfig.Config{},
+		nc: &network.Config{},
+		oc: &metrics.ObservabilityConfig{},
+		ac: &autoscalerconfig.Config{InitialScale: 1},
+		cc: &deployment.Config{},
+		want: makeDeployment(func(deploy *appsv1.Deployment) {
+			deploy.ObjectMeta.Annotations[autoscaling.InitialScaleAnnotationKey] = "4"
+			deploy.Spec.Template.ObjectMeta.Annotations[autoscaling.InitialScaleAnnotationKey] = "4"
+			deploy.Spec.Replicas = ptr.Int32(4)
+		}),
+	}, {
+		name: "with deployment config overrides",
+		rev: revision(
+			withoutLabels,
+			func(revision *v1.Revision) {
+				container(revision.Spec.GetContainer(),
+					withReadinessProbe(corev1.Handler{
+						TCPSocket: &corev1.TCPSocketAction{
+							Host: "127.0.0.1",
+							Port: intstr.FromInt(12345),
+						},
+					}),
+				)
+			},
+		),
+		lc: &logging.Config{},
+		tc: &tracingconfig.Config{},
+		nc: &network.Config{},
+		oc: &metrics.ObservabilityConfig{},
+		ac: &autoscalerconfig.Config{InitialScale: 1},
+		cc: &deployment.Config{
+			ProgressDeadline: 444 * time.Second,
+			PodLabels: map[string]string{
+				"environment": "devel",
+				// Labels the Revision itself sets are not overridden.
+				serving.RevisionUID: "not-this-one",
+			},
+			PodAnnotations: map[string]string{
+				"monitoring.example.com/scrape": "true",
+			},
+		},
+		want: makeDeployment(func(deploy *appsv1.Deployment) {
+			deploy.Spec.ProgressDeadlineSeconds = ptr.Int32(444)
+			deploy.Spec.Template.ObjectMeta.Labels["environment"] = "devel"
+			deploy.Spec.Template.ObjectMeta.Annotations["monitoring.example.com/scrape"] = "true"
+		}),
+	}, {
+		name: "with progress-deadline annotation override",
+		rev: revision(
+			withoutLabels,
+			func(revision *v1.Revision) {
+				revision.ObjectMeta.Annotations = map[string]string{
+					serving.ProgressDeadlineAnnotationKey: "66s",
+				}
+				container(revision.Spec.GetContainer(),
+					withReadinessProbe(corev1.Handler{
+						TCPSocket: &corev1.TCPSocketAction{
+							Host: "127.0.0.1",
+							Port: intstr.FromInt(12345),
+						},
+					}),
+				)
+			},
+		),
+		lc: &logging.Config{},
+		tc: &tracingconfig.Config{},
+		nc: &network.Config{},
+		oc: &metrics.ObservabilityConfig{},
+		ac: &autoscalerconfig.Config{InitialScale: 1},
+		cc: &deployment.Config{
+			ProgressDeadline: 444 * time.Second,
+		},
+		want: makeDeployment(func(deploy *appsv1.Deployment) {
+			deploy.ObjectMeta.Annotations[serving.ProgressDeadlineAnnotationKey] = "66s"
+			deploy.Spec.Template.ObjectMeta.Annotations[serving.ProgressDeadlineAnnotationKey] = "66s"
+			deploy.Spec.ProgressDeadlineSeconds = ptr.Int32(66)
+		}),
 	}}
 
 	for _, test := range tests {
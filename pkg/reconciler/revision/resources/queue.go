@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -79,12 +80,19 @@ var (
 	}
 )
 
-func createQueueResources(annotations map[string]string, userContainer *corev1.Container) corev1.ResourceRequirements {
+func createQueueResources(cfg *deployment.Config, annotations map[string]string, userContainer *corev1.Container) corev1.ResourceRequirements {
 	resourceRequests := corev1.ResourceList{corev1.ResourceCPU: queueContainerCPU}
 	resourceLimits := corev1.ResourceList{}
 	var requestCPU, limitCPU, requestMemory, limitMemory resource.Quantity
 
-	if resourceFraction, ok := fractionFromPercentage(annotations, serving.QueueSideCarResourcePercentageAnnotation); ok {
+	// The resource percentage annotation on the Revision takes precedence
+	// over the percentage configured in config-deployment.
+	resourceFraction, ok := fractionFromPercentage(annotations, serving.QueueSideCarResourcePercentageAnnotation)
+	if !ok && cfg.QueueSidecarResourcePercentage > 0 {
+		resourceFraction, ok = cfg.QueueSidecarResourcePercentage/100, true
+	}
+
+	if ok {
 		if ok, requestCPU = computeResourceRequirements(userContainer.Resources.Requests.Cpu(), resourceFraction, queueContainerRequestCPU); ok {
 			resourceRequests[corev1.ResourceCPU] = requestCPU
 		}
@@ -102,6 +110,34 @@ func createQueueResources(annotations map[string]string, userContainer *corev1.C
 		}
 	}
 
+	// Explicit resource settings from config-deployment override both the
+	// defaults and anything computed from the percentage.
+	for _, r := range []struct {
+		quantity *resource.Quantity
+		list     corev1.ResourceList
+		name     corev1.ResourceName
+	}{{
+		quantity: cfg.QueueSidecarCPURequest,
+		list:     resourceRequests,
+		name:     corev1.ResourceCPU,
+	}, {
+		quantity: cfg.QueueSidecarMemoryRequest,
+		list:     resourceRequests,
+		name:     corev1.ResourceMemory,
+	}, {
+		quantity: cfg.QueueSidecarCPULimit,
+		list:     resourceLimits,
+		name:     corev1.ResourceCPU,
+	}, {
+		quantity: cfg.QueueSidecarMemoryLimit,
+		list:     resourceLimits,
+		name:     corev1.ResourceMemory,
+	}} {
+		if r.quantity != nil {
+			r.list[r.name] = *r.quantity
+		}
+	}
+
 	resources := corev1.ResourceRequirements{
 		Requests: resourceRequests,
 	}
@@ -207,6 +243,16 @@ func makeQueueContainer(rev *v1.Revision, loggingConfig *logging.Config, tracing
 		ts = *rev.Spec.TimeoutSeconds
 	}
 
+	responseStartTimeout := int64(0)
+	if rev.Spec.ResponseStartTimeoutSeconds != nil {
+		responseStartTimeout = *rev.Spec.ResponseStartTimeoutSeconds
+	}
+
+	idleTimeout := int64(0)
+	if rev.Spec.IdleTimeoutSeconds != nil {
+		idleTimeout = *rev.Spec.IdleTimeoutSeconds
+	}
+
 	ports := queueNonServingPorts
 	if observabilityConfig.EnableProfiling {
 		ports = append(ports, profilingPort)
@@ -241,7 +287,7 @@ func makeQueueContainer(rev *v1.Revision, loggingConfig *logging.Config, tracing
 	return &corev1.Container{
 		Name:            QueueContainerName,
 		Image:           deploymentConfig.QueueSidecarImage,
-		Resources:       createQueueResources(rev.GetAnnotations(), container),
+		Resources:       createQueueResources(deploymentConfig, rev.GetAnnotations(), container),
 		Ports:           ports,
 		ReadinessProbe:  makeQueueProbe(rp),
 		VolumeMounts:    volumeMounts,
@@ -267,6 +313,15 @@ func makeQueueContainer(rev *v1.Revision, loggingConfig *logging.Config, tracing
 		}, {
 			Name:  "REVISION_TIMEOUT_SECONDS",
 			Value: strconv.Itoa(int(ts)),
+		}, {
+			Name:  "REVISION_RESPONSE_START_TIMEOUT_SECONDS",
+			Value: strconv.Itoa(int(responseStartTimeout)),
+		}, {
+			Name:  "REVISION_IDLE_TIMEOUT_SECONDS",
+			Value: strconv.Itoa(int(idleTimeout)),
+		}, {
+			Name:  "DRAIN_TIMEOUT_SECONDS",
+			Value: strconv.Itoa(int(deploymentConfig.QueueSidecarDrainTimeout.Seconds())),
 		}, {
 			Name: "SERVING_POD",
 			ValueFrom: &corev1.EnvVarSource{
@@ -308,9 +363,18 @@ func makeQueueContainer(rev *v1.Revision, loggingConfig *logging.Config, tracing
 		}, {
 			Name:  "TRACING_CONFIG_SAMPLE_RATE",
 			Value: fmt.Sprintf("%f", tracingConfig.SampleRate),
+		}, {
+			Name:  "CONCURRENCY_STATE_ENDPOINT",
+			Value: concurrencyStateEndpoint(rev, deploymentConfig),
+		}, {
+			Name:  "REJECTION_POLICY",
+			Value: rev.GetAnnotations()[serving.RejectionPolicyAnnotationKey],
 		}, {
 			Name:  "USER_PORT",
 			Value: strconv.Itoa(int(userPort)),
+		}, {
+			Name:  "USER_PORT_PROTOCOL",
+			Value: string(rev.GetProtocol()),
 		}, {
 			Name:  system.NamespaceEnvKey,
 			Value: system.Namespace(),
@@ -345,6 +409,16 @@ func makeQueueContainer(rev *v1.Revision, loggingConfig *logging.Config, tracing
 	}, nil
 }
 
+// concurrencyStateEndpoint returns the endpoint queue-proxy signals on
+// in-flight transitions, or empty if the cluster has no endpoint configured
+// or the Revision did not opt in via the container-freeze annotation.
+func concurrencyStateEndpoint(rev *v1.Revision, deploymentConfig *deployment.Config) string {
+	if !strings.EqualFold(rev.GetAnnotations()[serving.ContainerFreezeAnnotation], "enabled") {
+		return ""
+	}
+	return deploymentConfig.ConcurrencyStateEndpoint
+}
+
 func applyReadinessProbeDefaults(p *corev1.Probe, port int32) {
 	switch {
 	case p == nil:
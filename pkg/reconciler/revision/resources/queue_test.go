@@ -105,7 +105,72 @@ func TestMakeQueueContainer(t *testing.T) {
 		want: &corev1.Container{
 			// These are effectively constant
 			Name:            QueueContainerName,
-			Resources:       createQueueResources(make(map[string]string), &corev1.Container{}),
+			Resources:       createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
+			Ports:           append(queueNonServingPorts, queueHTTPPort),
+			ReadinessProbe:  defaultKnativeQReadinessProbe,
+			SecurityContext: queueSecurityContext,
+			// These changed based on the Revision and configs passed in.
+			Env: env(nil),
+		},
+	}, {
+		name: "container freeze enabled via annotation",
+		rev: &v1.Revision{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "bar",
+				UID:       "1234",
+				Annotations: map[string]string{
+					serving.ContainerFreezeAnnotation: "enabled",
+				},
+			},
+			Spec: v1.RevisionSpec{
+				ContainerConcurrency: ptr.Int64(1),
+				TimeoutSeconds:       ptr.Int64(45),
+			},
+		},
+		lc: &logging.Config{},
+		tc: &tracingconfig.Config{},
+		oc: &metrics.ObservabilityConfig{},
+		ac: &autoscalerconfig.Config{},
+		cc: &deployment.Config{
+			ConcurrencyStateEndpoint: "freeze-proxy",
+		},
+		want: &corev1.Container{
+			// These are effectively constant
+			Name:            QueueContainerName,
+			Resources:       createQueueResources(&deployment.Config{}, map[string]string{serving.ContainerFreezeAnnotation: "enabled"}, &corev1.Container{}),
+			Ports:           append(queueNonServingPorts, queueHTTPPort),
+			ReadinessProbe:  defaultKnativeQReadinessProbe,
+			SecurityContext: queueSecurityContext,
+			// These changed based on the Revision and configs passed in.
+			Env: env(map[string]string{
+				"CONCURRENCY_STATE_ENDPOINT": "freeze-proxy",
+			}),
+		},
+	}, {
+		name: "container freeze not enabled without annotation",
+		rev: &v1.Revision{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "bar",
+				UID:       "1234",
+			},
+			Spec: v1.RevisionSpec{
+				ContainerConcurrency: ptr.Int64(1),
+				TimeoutSeconds:       ptr.Int64(45),
+			},
+		},
+		lc: &logging.Config{},
+		tc: &tracingconfig.Config{},
+		oc: &metrics.ObservabilityConfig{},
+		ac: &autoscalerconfig.Config{},
+		cc: &deployment.Config{
+			ConcurrencyStateEndpoint: "freeze-proxy",
+		},
+		want: &corev1.Container{
+			// These are effectively constant
+			Name:            QueueContainerName,
+			Resources:       createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 			Ports:           append(queueNonServingPorts, queueHTTPPort),
 			ReadinessProbe:  defaultKnativeQReadinessProbe,
 			SecurityContext: queueSecurityContext,
@@ -145,7 +210,7 @@ func TestMakeQueueContainer(t *testing.T) {
 		want: &corev1.Container{
 			// These are effectively constant
 			Name:            QueueContainerName,
-			Resources:       createQueueResources(make(map[string]string), &corev1.Container{}),
+			Resources:       createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 			Ports:           append(queueNonServingPorts, queueHTTP2Port),
 			ReadinessProbe:  defaultKnativeQReadinessProbe,
 			SecurityContext: queueSecurityContext,
@@ -153,6 +218,7 @@ func TestMakeQueueContainer(t *testing.T) {
 			Image: "alpine",
 			Env: env(map[string]string{
 				"USER_PORT":          "1955",
+				"USER_PORT_PROTOCOL": "h2c",
 				"QUEUE_SERVING_PORT": "8013",
 			}),
 		},
@@ -182,7 +248,7 @@ func TestMakeQueueContainer(t *testing.T) {
 		want: &corev1.Container{
 			// These are effectively constant
 			Name:            QueueContainerName,
-			Resources:       createQueueResources(make(map[string]string), &corev1.Container{}),
+			Resources:       createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 			Ports:           append(queueNonServingPorts, queueHTTPPort),
 			ReadinessProbe:  defaultKnativeQReadinessProbe,
 			SecurityContext: queueSecurityContext,
@@ -219,7 +285,7 @@ func TestMakeQueueContainer(t *testing.T) {
 		want: &corev1.Container{
 			// These are effectively constant
 			Name:            QueueContainerName,
-			Resources:       createQueueResources(make(map[string]string), &corev1.Container{}),
+			Resources:       createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 			Ports:           append(queueNonServingPorts, queueHTTPPort),
 			ReadinessProbe:  defaultKnativeQReadinessProbe,
 			SecurityContext: queueSecurityContext,
@@ -257,7 +323,7 @@ func TestMakeQueueContainer(t *testing.T) {
 		want: &corev1.Container{
 			// These are effectively constant
 			Name:            QueueContainerName,
-			Resources:       createQueueResources(make(map[string]string), &corev1.Container{}),
+			Resources:       createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 			Ports:           append(queueNonServingPorts, queueHTTPPort),
 			ReadinessProbe:  defaultKnativeQReadinessProbe,
 			SecurityContext: queueSecurityContext,
@@ -291,7 +357,7 @@ func TestMakeQueueContainer(t *testing.T) {
 		want: &corev1.Container{
 			// These are effectively constant
 			Name:            QueueContainerName,
-			Resources:       createQueueResources(make(map[string]string), &corev1.Container{}),
+			Resources:       createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 			Ports:           append(queueNonServingPorts, queueHTTPPort),
 			ReadinessProbe:  defaultKnativeQReadinessProbe,
 			SecurityContext: queueSecurityContext,
@@ -324,7 +390,7 @@ func TestMakeQueueContainer(t *testing.T) {
 		want: &corev1.Container{
 			// These are effectively constant
 			Name:            QueueContainerName,
-			Resources:       createQueueResources(make(map[string]string), &corev1.Container{}),
+			Resources:       createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 			Ports:           append(queueNonServingPorts, queueHTTPPort),
 			ReadinessProbe:  defaultKnativeQReadinessProbe,
 			SecurityContext: queueSecurityContext,
@@ -358,7 +424,7 @@ func TestMakeQueueContainer(t *testing.T) {
 		want: &corev1.Container{
 			// These are effectively constant
 			Name:            QueueContainerName,
-			Resources:       createQueueResources(make(map[string]string), &corev1.Container{}),
+			Resources:       createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 			Ports:           append(queueNonServingPorts, queueHTTPPort),
 			ReadinessProbe:  defaultKnativeQReadinessProbe,
 			SecurityContext: queueSecurityContext,
@@ -389,7 +455,7 @@ func TestMakeQueueContainer(t *testing.T) {
 		want: &corev1.Container{
 			// These are effectively constant
 			Name:            QueueContainerName,
-			Resources:       createQueueResources(make(map[string]string), &corev1.Container{}),
+			Resources:       createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 			Ports:           append(queueNonServingPorts, profilingPort, queueHTTPPort),
 			ReadinessProbe:  defaultKnativeQReadinessProbe,
 			SecurityContext: queueSecurityContext,
@@ -695,6 +761,224 @@ func TestMakeQueueContainerWithPercentageAnnotation(t *testing.T) {
 	}
 }
 
+func TestMakeQueueContainerWithResourceConfig(t *testing.T) {
+	quantity := func(s string) *resource.Quantity {
+		q := resource.MustParse(s)
+		return &q
+	}
+
+	tests := []struct {
+		name string
+		rev  *v1.Revision
+		lc   *logging.Config
+		tc   *tracingconfig.Config
+		oc   *metrics.ObservabilityConfig
+		ac   *autoscalerconfig.Config
+		cc   *deployment.Config
+		want *corev1.Container
+	}{{
+		name: "resources percentage in config-deployment",
+		rev: &v1.Revision{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "bar",
+				UID:       "1234",
+				Labels: map[string]string{
+					serving.ServiceLabelKey: "svc",
+				},
+			},
+			Spec: v1.RevisionSpec{
+				ContainerConcurrency: ptr.Int64(1),
+				TimeoutSeconds:       ptr.Int64(45),
+				PodSpec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:           containerName,
+						ReadinessProbe: testProbe,
+						Resources: corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceName("memory"): resource.MustParse("2Gi"),
+								corev1.ResourceName("cpu"):    resource.MustParse("2"),
+							},
+						},
+					}},
+				},
+			},
+		},
+		lc: &logging.Config{},
+		tc: &tracingconfig.Config{},
+		oc: &metrics.ObservabilityConfig{},
+		ac: &autoscalerconfig.Config{},
+		cc: &deployment.Config{
+			QueueSidecarImage:              "alpine",
+			QueueSidecarResourcePercentage: 20,
+		},
+		want: &corev1.Container{
+			// These are effectively constant
+			Name: QueueContainerName,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceName("cpu"): resource.MustParse("25m"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceName("memory"): *resource.NewMilliQuantity(429496729600, resource.BinarySI),
+					corev1.ResourceName("cpu"):    *resource.NewMilliQuantity(400, resource.BinarySI),
+				},
+			},
+			Ports:           append(queueNonServingPorts, queueHTTPPort),
+			ReadinessProbe:  defaultKnativeQReadinessProbe,
+			SecurityContext: queueSecurityContext,
+			// These changed based on the Revision and configs passed in.
+			Image: "alpine",
+			Env: env(map[string]string{
+				"SERVING_SERVICE": "svc",
+			}),
+		}}, {
+		name: "explicit queue sidecar resources override",
+		rev: &v1.Revision{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "bar",
+				UID:       "1234",
+				Labels: map[string]string{
+					serving.ServiceLabelKey: "svc",
+				},
+			},
+			Spec: v1.RevisionSpec{
+				ContainerConcurrency: ptr.Int64(1),
+				TimeoutSeconds:       ptr.Int64(45),
+				PodSpec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:           containerName,
+						ReadinessProbe: testProbe,
+					}},
+				},
+			},
+		},
+		lc: &logging.Config{},
+		tc: &tracingconfig.Config{},
+		oc: &metrics.ObservabilityConfig{},
+		ac: &autoscalerconfig.Config{},
+		cc: &deployment.Config{
+			QueueSidecarImage:         "alpine",
+			QueueSidecarCPURequest:    quantity("30m"),
+			QueueSidecarCPULimit:      quantity("2"),
+			QueueSidecarMemoryRequest: quantity("100Mi"),
+			QueueSidecarMemoryLimit:   quantity("200Mi"),
+		},
+		want: &corev1.Container{
+			// These are effectively constant
+			Name: QueueContainerName,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceName("cpu"):    resource.MustParse("30m"),
+					corev1.ResourceName("memory"): resource.MustParse("100Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceName("cpu"):    resource.MustParse("2"),
+					corev1.ResourceName("memory"): resource.MustParse("200Mi"),
+				},
+			},
+			Ports:           append(queueNonServingPorts, queueHTTPPort),
+			ReadinessProbe:  defaultKnativeQReadinessProbe,
+			SecurityContext: queueSecurityContext,
+			// These changed based on the Revision and configs passed in.
+			Image: "alpine",
+			Env: env(map[string]string{
+				"SERVING_SERVICE": "svc",
+			}),
+		}}, {
+		name: "percentage annotation takes precedence over config percentage",
+		rev: &v1.Revision{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "bar",
+				UID:       "1234",
+				Labels: map[string]string{
+					serving.ServiceLabelKey: "svc",
+				},
+				Annotations: map[string]string{
+					serving.QueueSideCarResourcePercentageAnnotation: "20",
+				},
+			},
+			Spec: v1.RevisionSpec{
+				ContainerConcurrency: ptr.Int64(1),
+				TimeoutSeconds:       ptr.Int64(45),
+				PodSpec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:           containerName,
+						ReadinessProbe: testProbe,
+						Resources: corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceName("memory"): resource.MustParse("2Gi"),
+								corev1.ResourceName("cpu"):    resource.MustParse("2"),
+							},
+						},
+					}},
+				},
+			},
+		},
+		lc: &logging.Config{},
+		tc: &tracingconfig.Config{},
+		oc: &metrics.ObservabilityConfig{},
+		ac: &autoscalerconfig.Config{},
+		cc: &deployment.Config{
+			QueueSidecarImage:              "alpine",
+			QueueSidecarResourcePercentage: 100,
+		},
+		want: &corev1.Container{
+			// These are effectively constant
+			Name: QueueContainerName,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceName("cpu"): resource.MustParse("25m"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceName("memory"): *resource.NewMilliQuantity(429496729600, resource.BinarySI),
+					corev1.ResourceName("cpu"):    *resource.NewMilliQuantity(400, resource.BinarySI),
+				},
+			},
+			Ports:           append(queueNonServingPorts, queueHTTPPort),
+			ReadinessProbe:  defaultKnativeQReadinessProbe,
+			SecurityContext: queueSecurityContext,
+			// These changed based on the Revision and configs passed in.
+			Image: "alpine",
+			Env: env(map[string]string{
+				"SERVING_SERVICE": "svc",
+			}),
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := makeQueueContainer(test.rev, test.lc, test.tc, test.oc, test.ac, test.cc)
+			if err != nil {
+				t.Fatal("makeQueueContainer returned error")
+			}
+			test.want.Env = append(test.want.Env, corev1.EnvVar{
+				Name:  "SERVING_READINESS_PROBE",
+				Value: probeJSON(test.rev.Spec.GetContainer()),
+			})
+			sortEnv(got.Env)
+			sortEnv(test.want.Env)
+			if diff := cmp.Diff(test.want, got, cmpopts.IgnoreUnexported(resource.Quantity{})); diff != "" {
+				t.Errorf("makeQueueContainerWithResourceConfig (-want, +got) = %v", diff)
+			}
+			if test.want.Resources.Limits.Memory().Cmp(*got.Resources.Limits.Memory()) != 0 {
+				t.Errorf("Resources.Limits.Memory = %v, want: %v", got.Resources.Limits.Memory(), test.want.Resources.Limits.Memory())
+			}
+			if test.want.Resources.Requests.Cpu().Cmp(*got.Resources.Requests.Cpu()) != 0 {
+				t.Errorf("Resources.Request.CPU = %v, want: %v", got.Resources.Requests.Cpu(), test.want.Resources.Requests.Cpu())
+			}
+			if test.want.Resources.Requests.Memory().Cmp(*got.Resources.Requests.Memory()) != 0 {
+				t.Errorf("Resources.Requests.Memory = %v, want: %v", got.Resources.Requests.Memory(), test.want.Resources.Requests.Memory())
+			}
+			if test.want.Resources.Limits.Cpu().Cmp(*got.Resources.Limits.Cpu()) != 0 {
+				t.Errorf("Resources.Limits.CPU  = %v, want: %v", got.Resources.Limits.Cpu(), test.want.Resources.Limits.Cpu())
+			}
+		})
+	}
+}
+
 func TestProbeGenerationHTTPDefaults(t *testing.T) {
 	rev := &v1.Revision{
 		ObjectMeta: metav1.ObjectMeta{
@@ -752,7 +1036,7 @@ func TestProbeGenerationHTTPDefaults(t *testing.T) {
 	want := &corev1.Container{
 		// These are effectively constant
 		Name:      QueueContainerName,
-		Resources: createQueueResources(make(map[string]string), &corev1.Container{}),
+		Resources: createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 		Ports:     append(queueNonServingPorts, queueHTTPPort),
 		ReadinessProbe: &corev1.Probe{
 			Handler: corev1.Handler{
@@ -844,7 +1128,7 @@ func TestProbeGenerationHTTP(t *testing.T) {
 	want := &corev1.Container{
 		// These are effectively constant
 		Name:      QueueContainerName,
-		Resources: createQueueResources(make(map[string]string), &corev1.Container{}),
+		Resources: createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 		Ports:     append(queueNonServingPorts, queueHTTPPort),
 		ReadinessProbe: &corev1.Probe{
 			Handler: corev1.Handler{
@@ -914,7 +1198,7 @@ func TestTCPProbeGeneration(t *testing.T) {
 		want: &corev1.Container{
 			// These are effectively constant
 			Name:      QueueContainerName,
-			Resources: createQueueResources(make(map[string]string), &corev1.Container{}),
+			Resources: createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 			Ports:     append(queueNonServingPorts, queueHTTPPort),
 			ReadinessProbe: &corev1.Probe{
 				Handler: corev1.Handler{
@@ -959,7 +1243,7 @@ func TestTCPProbeGeneration(t *testing.T) {
 		want: &corev1.Container{
 			// These are effectively constant
 			Name:      QueueContainerName,
-			Resources: createQueueResources(make(map[string]string), &corev1.Container{}),
+			Resources: createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 			Ports:     append(queueNonServingPorts, queueHTTPPort),
 			ReadinessProbe: &corev1.Probe{
 				Handler: corev1.Handler{
@@ -1014,7 +1298,7 @@ func TestTCPProbeGeneration(t *testing.T) {
 		want: &corev1.Container{
 			// These are effectively constant
 			Name:      QueueContainerName,
-			Resources: createQueueResources(make(map[string]string), &corev1.Container{}),
+			Resources: createQueueResources(&deployment.Config{}, make(map[string]string), &corev1.Container{}),
 			Ports:     append(queueNonServingPorts, queueHTTPPort),
 			ReadinessProbe: &corev1.Probe{
 				Handler: corev1.Handler{
@@ -1071,32 +1355,38 @@ func TestTCPProbeGeneration(t *testing.T) {
 }
 
 var defaultEnv = map[string]string{
-	"SERVING_NAMESPACE":                     "foo",
-	"SERVING_SERVICE":                       "",
-	"SERVING_CONFIGURATION":                 "",
-	"SERVING_REVISION":                      "bar",
-	"CONTAINER_CONCURRENCY":                 "1",
-	"REVISION_TIMEOUT_SECONDS":              "45",
-	"SERVING_LOGGING_CONFIG":                "",
-	"SERVING_LOGGING_LEVEL":                 "",
-	"TRACING_CONFIG_BACKEND":                "",
-	"TRACING_CONFIG_ZIPKIN_ENDPOINT":        "",
-	"TRACING_CONFIG_STACKDRIVER_PROJECT_ID": "",
-	"TRACING_CONFIG_SAMPLE_RATE":            "0.000000",
-	"TRACING_CONFIG_DEBUG":                  "false",
-	"SERVING_REQUEST_LOG_TEMPLATE":          "",
-	"SERVING_REQUEST_METRICS_BACKEND":       "",
-	"USER_PORT":                             strconv.Itoa(v1.DefaultUserPort),
-	"SYSTEM_NAMESPACE":                      system.Namespace(),
-	"METRICS_DOMAIN":                        metrics.Domain(),
-	"QUEUE_SERVING_PORT":                    "8012",
-	"USER_CONTAINER_NAME":                   containerName,
-	"ENABLE_VAR_LOG_COLLECTION":             "false",
-	"VAR_LOG_VOLUME_NAME":                   varLogVolumeName,
-	"INTERNAL_VOLUME_PATH":                  internalVolumePath,
-	"DOWNWARD_API_LABELS_PATH":              fmt.Sprintf("%s/%s", podInfoVolumePath, metadataLabelsPath),
-	"ENABLE_PROFILING":                      "false",
-	"SERVING_ENABLE_PROBE_REQUEST_LOG":      "false",
+	"SERVING_NAMESPACE":                       "foo",
+	"SERVING_SERVICE":                         "",
+	"SERVING_CONFIGURATION":                   "",
+	"SERVING_REVISION":                        "bar",
+	"CONTAINER_CONCURRENCY":                   "1",
+	"REVISION_TIMEOUT_SECONDS":                "45",
+	"REVISION_RESPONSE_START_TIMEOUT_SECONDS": "0",
+	"REVISION_IDLE_TIMEOUT_SECONDS":           "0",
+	"SERVING_LOGGING_CONFIG":                  "",
+	"SERVING_LOGGING_LEVEL":                   "",
+	"TRACING_CONFIG_BACKEND":                  "",
+	"TRACING_CONFIG_ZIPKIN_ENDPOINT":          "",
+	"TRACING_CONFIG_STACKDRIVER_PROJECT_ID":   "",
+	"TRACING_CONFIG_SAMPLE_RATE":              "0.000000",
+	"TRACING_CONFIG_DEBUG":                    "false",
+	"SERVING_REQUEST_LOG_TEMPLATE":            "",
+	"SERVING_REQUEST_METRICS_BACKEND":         "",
+	"USER_PORT":                               strconv.Itoa(v1.DefaultUserPort),
+	"USER_PORT_PROTOCOL":                      string(networking.ProtocolHTTP1),
+	"SYSTEM_NAMESPACE":                        system.Namespace(),
+	"METRICS_DOMAIN":                          metrics.Domain(),
+	"QUEUE_SERVING_PORT":                      "8012",
+	"USER_CONTAINER_NAME":                     containerName,
+	"ENABLE_VAR_LOG_COLLECTION":               "false",
+	"VAR_LOG_VOLUME_NAME":                     varLogVolumeName,
+	"INTERNAL_VOLUME_PATH":                    internalVolumePath,
+	"DOWNWARD_API_LABELS_PATH":                fmt.Sprintf("%s/%s", podInfoVolumePath, metadataLabelsPath),
+	"CONCURRENCY_STATE_ENDPOINT":              "",
+	"REJECTION_POLICY":                        "",
+	"DRAIN_TIMEOUT_SECONDS":                   "0",
+	"ENABLE_PROFILING":                        "false",
+	"SERVING_ENABLE_PROBE_REQUEST_LOG":        "false",
 }
 
 func probeJSON(container *corev1.Container) string {
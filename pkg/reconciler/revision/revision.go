@@ -24,6 +24,7 @@ import (
 	"github.com/google/go-containerregistry/pkg/authn/k8schain"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	appsv1listers "k8s.io/client-go/listers/apps/v1"
@@ -41,7 +42,8 @@ import (
 )
 
 type resolver interface {
-	Resolve(string, k8schain.Options, sets.String) (string, error)
+	Resolve(*v1.Revision, k8schain.Options, sets.String) (string, bool, error)
+	Clear(types.NamespacedName)
 }
 
 // Reconciler implements controller.Reconciler for Revision resources.
@@ -62,10 +64,22 @@ type Reconciler struct {
 // Check that our Reconciler implements revisionreconciler.Interface
 var _ revisionreconciler.Interface = (*Reconciler)(nil)
 
-func (c *Reconciler) reconcileDigest(ctx context.Context, rev *v1.Revision) error {
+// reconcileDigest resolves the image tag of the Revision to a digest. The
+// resolution happens in the background; the returned boolean reports whether
+// it has completed, and the Revision is re-enqueued once it has.
+func (c *Reconciler) reconcileDigest(ctx context.Context, rev *v1.Revision) (bool, error) {
 	// The image digest has already been resolved.
 	if rev.Status.ImageDigest != "" {
-		return nil
+		c.resolver.Clear(types.NamespacedName{Namespace: rev.Namespace, Name: rev.Name})
+		// Fill in the per-container statuses for Revisions resolved
+		// before the field existed.
+		if len(rev.Status.ContainerStatuses) == 0 {
+			rev.Status.ContainerStatuses = []v1.ContainerStatus{{
+				Name:        rev.Spec.GetContainer().Name,
+				ImageDigest: rev.Status.ImageDigest,
+			}}
+		}
+		return true, nil
 	}
 
 	var imagePullSecrets []string
@@ -78,19 +92,30 @@ func (c *Reconciler) reconcileDigest(ctx context.Context, rev *v1.Revision) erro
 		ServiceAccountName: rev.Spec.ServiceAccountName,
 		ImagePullSecrets:   imagePullSecrets,
 	}
-	digest, err := c.resolver.Resolve(rev.Spec.GetContainer().Image,
-		opt, cfgs.Deployment.RegistriesSkippingTagResolving)
+	digest, ready, err := c.resolver.Resolve(rev, opt, cfgs.Deployment.RegistriesSkippingTagResolving)
 	if err != nil {
+		// Clear the resolution so that a later reconciliation retries it
+		// rather than being stuck with this error.
+		c.resolver.Clear(types.NamespacedName{Namespace: rev.Namespace, Name: rev.Name})
 		err = fmt.Errorf("failed to resolve image to digest: %w", err)
-		rev.Status.MarkContainerHealthyFalse(v1.ReasonContainerMissing,
+		rev.Status.MarkContainerHealthyFalse(v1.ReasonImageResolutionFailed,
 			v1.RevisionContainerMissingMessage(
 				rev.Spec.GetContainer().Image, err.Error()))
-		return err
+		return false, err
+	}
+	if !ready {
+		return false, nil
 	}
 
 	rev.Status.ImageDigest = digest
+	if digest != "" {
+		rev.Status.ContainerStatuses = []v1.ContainerStatus{{
+			Name:        rev.Spec.GetContainer().Name,
+			ImageDigest: digest,
+		}}
+	}
 
-	return nil
+	return true, nil
 }
 
 func (c *Reconciler) ReconcileKind(ctx context.Context, rev *v1.Revision) pkgreconciler.Event {
@@ -104,13 +129,21 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, rev *v1.Revision) pkgrec
 	rev.Status.InitializeConditions()
 	c.updateRevisionLoggingURL(ctx, rev)
 
+	if resolved, err := c.reconcileDigest(ctx, rev); err != nil {
+		return err
+	} else if !resolved {
+		// The digest is being resolved in the background; the Revision is
+		// re-enqueued once the resolution completes.
+		rev.Status.MarkResourcesAvailableUnknown(v1.ReasonResolvingDigests,
+			"Resolving the digest of the container image")
+		rev.Status.ObservedGeneration = rev.Generation
+		return nil
+	}
+
 	phases := []struct {
 		name string
 		f    func(context.Context, *v1.Revision) error
 	}{{
-		name: "image digest",
-		f:    c.reconcileDigest,
-	}, {
 		name: "user deployment",
 		f:    c.reconcileDeployment,
 	}, {
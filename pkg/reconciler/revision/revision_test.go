@@ -31,7 +31,7 @@ import (
 	fakecachingclient "knative.dev/caching/pkg/client/injection/client/fake"
 	fakeimageinformer "knative.dev/caching/pkg/client/injection/informers/caching/v1alpha1/image/fake"
 	fakekubeclient "knative.dev/pkg/client/injection/kube/client/fake"
-	fakedeploymentinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment/fake"
+	fakedeploymentinformer "knative.dev/serving/pkg/client/injection/kube/informers/filtered/apps/v1/deployment/fake"
 	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/fake"
 	fakeendpointsinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/endpoints/fake"
 	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/service/fake"
@@ -47,6 +47,7 @@ import (
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"knative.dev/pkg/apis"
@@ -104,6 +105,7 @@ func testReadyEndpoints(revName string) *corev1.Endpoints {
 func testReadyPA(rev *v1.Revision) *av1alpha1.PodAutoscaler {
 	pa := resources.MakePA(rev)
 	pa.Status.InitializeConditions()
+	pa.Status.MarkScaleTargetInitialized()
 	pa.Status.MarkActive()
 	pa.Status.ServiceName = serviceName(rev.Name)
 	return pa
@@ -254,18 +256,22 @@ type fixedResolver struct {
 	digest string
 }
 
-func (r *fixedResolver) Resolve(_ string, _ k8schain.Options, _ sets.String) (string, error) {
-	return r.digest, nil
+func (r *fixedResolver) Resolve(_ *v1.Revision, _ k8schain.Options, _ sets.String) (string, bool, error) {
+	return r.digest, true, nil
 }
 
+func (r *fixedResolver) Clear(types.NamespacedName) {}
+
 type errorResolver struct {
 	err error
 }
 
-func (r *errorResolver) Resolve(_ string, _ k8schain.Options, _ sets.String) (string, error) {
-	return "", r.err
+func (r *errorResolver) Resolve(_ *v1.Revision, _ k8schain.Options, _ sets.String) (string, bool, error) {
+	return "", true, r.err
 }
 
+func (r *errorResolver) Clear(types.NamespacedName) {}
+
 func TestResolutionFailed(t *testing.T) {
 	// Unconditionally return this error during resolution.
 	innerError := errors.New("i am the expected error message, hear me ROAR!")
@@ -291,7 +297,7 @@ func TestResolutionFailed(t *testing.T) {
 		want := &apis.Condition{
 			Type:   ct,
 			Status: corev1.ConditionFalse,
-			Reason: "ContainerMissing",
+			Reason: "ImageResolutionFailed",
 			Message: v1.RevisionContainerMissingMessage(
 				rev.Spec.GetContainer().Image, "failed to resolve image to digest: "+innerError.Error()),
 			LastTransitionTime: got.LastTransitionTime,
@@ -412,19 +412,21 @@ func TestReconcile(t *testing.T) {
 		Key: "foo/deploy-replica-failure",
 	}, {
 		Name: "surface ImagePullBackoff",
-		// Test the propagation of ImagePullBackoff from user container.
+		// Test the propagation of ImagePullBackOff from user container.
+		// The failure is surfaced on the revision without waiting for the
+		// deployment's progress deadline to expire.
 		Objects: []runtime.Object{
 			rev("foo", "pull-backoff",
 				withK8sServiceName("the-taxman"), WithLogURL, MarkActivating("Deploying", "")),
-			pa("foo", "pull-backoff", WithReachability(asv1a1.ReachabilityUnknown)), // pa can't be ready since deployment times out.
-			pod(t, "foo", "pull-backoff", WithWaitingContainer("user-container", "ImagePullBackoff", "can't pull it")),
-			timeoutDeploy(deploy(t, "foo", "pull-backoff"), "Timed out!"),
+			pa("foo", "pull-backoff", WithReachability(asv1a1.ReachabilityUnknown)), // pa can't be ready since the container doesn't come up.
+			pod(t, "foo", "pull-backoff", WithWaitingContainer("user-container", "ImagePullBackOff", "can't pull it")),
+			deploy(t, "foo", "pull-backoff"),
 			image("foo", "pull-backoff"),
 		},
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
 			Object: rev("foo", "pull-backoff",
 				WithLogURL, AllUnknownConditions,
-				MarkResourcesUnavailable("ImagePullBackoff", "can't pull it")),
+				MarkContainerUnhealthy("ImagePullBackOff", "can't pull it")),
 		}},
 		WantUpdates: []clientgotesting.UpdateActionImpl{{
 			Object: pa("foo", "pull-backoff", WithReachability(asv1a1.ReachabilityUnreachable)),
@@ -449,6 +451,23 @@ func TestReconcile(t *testing.T) {
 				WithLogURL, AllUnknownConditions, MarkContainerExiting(5, v1.RevisionContainerExitingMessage("I failed man!"))),
 		}},
 		Key: "foo/pod-error",
+	}, {
+		Name: "surface OOMKilled pod errors",
+		// Test that a termination that carries its diagnostics in the reason
+		// rather than the message (e.g. OOMKilled) is still surfaced.
+		Objects: []runtime.Object{
+			rev("foo", "pod-oom",
+				withK8sServiceName("a-pod-oom"), WithLogURL, AllUnknownConditions, MarkActive),
+			pa("foo", "pod-oom"), // PA can't be ready, since no traffic.
+			pod(t, "foo", "pod-oom", WithOOMKilledContainer("user-container")),
+			deploy(t, "foo", "pod-oom"),
+			image("foo", "pod-oom"),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: rev("foo", "pod-oom",
+				WithLogURL, AllUnknownConditions, MarkContainerExiting(137, v1.RevisionContainerExitingMessage("OOMKilled"))),
+		}},
+		Key: "foo/pod-oom",
 	}, {
 		Name: "surface pod schedule errors",
 		// Test the propagation of the scheduling errors of Pod into the revision.
@@ -748,6 +767,6 @@ func ReconcilerTestConfig() *config.Config {
 		},
 		Logging:    &logging.Config{},
 		Tracing:    &tracingconfig.Config{},
-		Autoscaler: &autoscalerconfig.Config{},
+		Autoscaler: &autoscalerconfig.Config{InitialScale: 1},
 	}
 }
@@ -174,6 +174,9 @@ func TestLookupDomainForLabels(t *testing.T) {
 	}, {
 		labels: map[string]string{"serving.knative.dev/visibility": "cluster-local"},
 		domain: "svc." + network.GetClusterDomainName(),
+	}, {
+		labels: map[string]string{"networking.knative.dev/visibility": "cluster-local"},
+		domain: "svc." + network.GetClusterDomainName(),
 	}}
 
 	for _, expected := range expectations {
@@ -26,12 +26,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 	pkgnet "knative.dev/pkg/network"
+	"knative.dev/serving/pkg/apis/networking"
 	netv1alpha1 "knative.dev/serving/pkg/apis/networking/v1alpha1"
 	"knative.dev/serving/pkg/apis/serving"
 	v1 "knative.dev/serving/pkg/apis/serving/v1"
 	"knative.dev/serving/pkg/network"
 	"knative.dev/serving/pkg/reconciler/route/config"
 	"knative.dev/serving/pkg/reconciler/route/resources/labels"
+	"knative.dev/serving/pkg/resources"
 )
 
 // HTTPScheme is the string representation of http.
@@ -65,7 +67,11 @@ func GetAllDomainsAndTags(ctx context.Context, r *v1.Route, names []string, visi
 func DomainNameFromTemplate(ctx context.Context, r metav1.ObjectMeta, name string) (string, error) {
 	domainConfig := config.FromContext(ctx).Domain
 	rLabels := r.Labels
-	domain := domainConfig.LookupDomainForLabels(rLabels)
+	// The Route's namespace is matchable by config-domain selectors through
+	// the routeNamespace label, so operators can map whole namespaces to a
+	// domain suffix without labelling every Route.
+	domain := domainConfig.LookupDomainForLabels(resources.UnionMaps(
+		rLabels, map[string]string{serving.RouteNamespaceLabelKey: r.Namespace}))
 	annotations := r.Annotations
 	// These are the available properties they can choose from.
 	// We could add more over time - e.g. RevisionName if we thought that
@@ -83,9 +89,22 @@ func DomainNameFromTemplate(ctx context.Context, r metav1.ObjectMeta, name strin
 	var templ *template.Template
 	// If the route is "cluster local" then don't use the user-defined
 	// domain template, use the default one
-	if rLabels[serving.VisibilityLabelKey] == serving.VisibilityClusterLocal {
+	if rLabels[networking.VisibilityLabelKey] == serving.VisibilityClusterLocal ||
+		rLabels[serving.VisibilityLabelKey] == serving.VisibilityClusterLocal {
 		templ = template.Must(template.New("domain-template").Parse(
 			network.DefaultDomainTemplate))
+	} else if override := annotations[networking.DomainTemplateAnnotationKey]; override != "" {
+		// An annotation on the Route overrides the cluster-wide template,
+		// so operators can customize hostnames per namespace (or Route)
+		// without changing config-network.
+		var err error
+		templ, err = template.New("domain-template").Parse(override)
+		if err == nil {
+			err = network.CheckDomainTemplate(templ)
+		}
+		if err != nil {
+			return "", fmt.Errorf("invalid domain template annotation %q: %w", override, err)
+		}
 	} else {
 		templ = networkConfig.GetDomainTemplate()
 	}
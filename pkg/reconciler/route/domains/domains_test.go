@@ -25,6 +25,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"knative.dev/pkg/apis"
 
+	"knative.dev/serving/pkg/apis/networking"
 	"knative.dev/serving/pkg/apis/serving"
 	v1 "knative.dev/serving/pkg/apis/serving/v1"
 	"knative.dev/serving/pkg/gc"
@@ -145,6 +146,100 @@ func TestDomainNameFromTemplate(t *testing.T) {
 	}
 }
 
+func TestDomainNameFromNamespaceSelector(t *testing.T) {
+	cfg := testConfig()
+	cfg.Domain.Domains["internal.example.net"] = &config.LabelSelector{
+		Selector: map[string]string{serving.RouteNamespaceLabelKey: "internal"},
+	}
+	ctx := config.ToContext(context.Background(), cfg)
+
+	tests := []struct {
+		name      string
+		namespace string
+		want      string
+	}{{
+		name:      "MatchingNamespace",
+		namespace: "internal",
+		want:      "test-name.internal.internal.example.net",
+	}, {
+		name:      "OtherNamespace",
+		namespace: "default",
+		want:      "test-name.default.example.com",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := metav1.ObjectMeta{
+				Name:      "myroute",
+				Namespace: tt.namespace,
+			}
+
+			got, err := DomainNameFromTemplate(ctx, meta, "test-name")
+			if err != nil {
+				t.Errorf("DomainNameFromTemplate() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("DomainNameFromTemplate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomainNameFromAnnotationTemplate(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation string
+		want       string
+		wantErr    bool
+		local      bool
+	}{{
+		name:       "Override",
+		annotation: "{{.Name}}-{{.Namespace}}.{{.Domain}}",
+		want:       "test-name-default.example.com",
+	}, {
+		name:       "OverrideIgnoredWhenLocal",
+		annotation: "{{.Name}}-{{.Namespace}}.{{.Domain}}",
+		want:       "test-name.default.svc.cluster.local",
+		local:      true,
+	}, {
+		name:       "Unparseable",
+		annotation: "{{.Name}.{{.Domain}}",
+		wantErr:    true,
+	}, {
+		name:       "BadVarName",
+		annotation: "{{.Name}}.{{.NNNamespace}}.{{.Domain}}",
+		wantErr:    true,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := config.ToContext(context.Background(), testConfig())
+
+			meta := metav1.ObjectMeta{
+				Name:      "myroute",
+				Namespace: "default",
+				Labels:    map[string]string{},
+				Annotations: map[string]string{
+					networking.DomainTemplateAnnotationKey: tt.annotation,
+				},
+			}
+			if tt.local {
+				meta.Labels[serving.VisibilityLabelKey] = serving.VisibilityClusterLocal
+			}
+
+			got, err := DomainNameFromTemplate(ctx, meta, "test-name")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DomainNameFromTemplate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("DomainNameFromTemplate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestURL(t *testing.T) {
 	tests := []struct {
 		name     string
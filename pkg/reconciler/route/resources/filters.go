@@ -18,6 +18,7 @@ package resources
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"knative.dev/serving/pkg/apis/networking"
 	"knative.dev/serving/pkg/apis/serving"
 )
 
@@ -42,6 +43,6 @@ func FilterService(services []*corev1.Service, acceptFilter Filter) []*corev1.Se
 
 // IsClusterLocalService returns whether a service is cluster local.
 func IsClusterLocalService(svc *corev1.Service) bool {
-	visibility, ok := svc.GetLabels()[serving.VisibilityLabelKey]
-	return ok && serving.VisibilityClusterLocal == visibility
+	return svc.GetLabels()[serving.VisibilityLabelKey] == serving.VisibilityClusterLocal ||
+		svc.GetLabels()[networking.VisibilityLabelKey] == serving.VisibilityClusterLocal
 }
@@ -19,6 +19,7 @@ package resources
 import (
 	"context"
 	"sort"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -112,7 +113,7 @@ func MakeIngressSpec(
 			if err != nil {
 				return v1alpha1.IngressSpec{}, err
 			}
-			rule := *makeIngressRule([]string{domain}, r.Namespace, visibility, targets[name])
+			rule := *makeIngressRule([]string{domain}, r, visibility, targets[name])
 			// If this is a public rule, we need to configure ACME challenge paths.
 			if visibility == netv1alpha1.IngressVisibilityExternalIP {
 				rule.HTTP.Paths = append(
@@ -174,13 +175,34 @@ func makeACMEIngressPaths(challenges map[string]v1alpha1.HTTP01Challenge, domain
 	return paths
 }
 
-func makeIngressRule(domains []string, ns string, visibility netv1alpha1.IngressVisibility, targets traffic.RevisionTargets) *v1alpha1.IngressRule {
+func makeIngressRule(domains []string, r *servingv1.Route, visibility netv1alpha1.IngressVisibility, targets traffic.RevisionTargets) *v1alpha1.IngressRule {
+	ns := r.Namespace
+	retries := makeIngressRetries(r.Spec.Retries)
+
+	// Header-matched paths are listed first so that requests carrying the
+	// headers reach their target regardless of the percentage based splits.
+	var paths []v1alpha1.HTTPIngressPath
+	for _, t := range targets {
+		if len(t.Headers) == 0 {
+			continue
+		}
+		headerPath := makeHeaderMatchIngressPath(ns, t)
+		headerPath.Retries = retries
+		paths = append(paths, *headerPath)
+	}
+
 	// Optimistically allocate |targets| elements.
 	splits := make([]v1alpha1.IngressBackendSplit, 0, len(targets))
+	// The ingress timeout must cover the slowest revision being routed to,
+	// so that it agrees with the timeout the queue-proxy enforces.
+	var timeoutSeconds int64
 	for _, t := range targets {
 		if t.Percent == nil || *t.Percent == 0 {
 			continue
 		}
+		if t.TimeoutSeconds > timeoutSeconds {
+			timeoutSeconds = t.TimeoutSeconds
+		}
 
 		splits = append(splits, v1alpha1.IngressBackendSplit{
 			IngressBackend: v1alpha1.IngressBackend{
@@ -198,14 +220,66 @@ func makeIngressRule(domains []string, ns string, visibility netv1alpha1.Ingress
 		})
 	}
 
+	path := v1alpha1.HTTPIngressPath{
+		Splits:  splits,
+		Retries: retries,
+	}
+	// An explicit timeout on the Route wins over the per-Revision default.
+	if r.Spec.TimeoutSeconds != nil {
+		timeoutSeconds = *r.Spec.TimeoutSeconds
+	}
+	if timeoutSeconds > 0 {
+		path.Timeout = &metav1.Duration{Duration: time.Duration(timeoutSeconds) * time.Second}
+	}
+
 	return &v1alpha1.IngressRule{
 		Hosts:      domains,
 		Visibility: visibility,
 		HTTP: &v1alpha1.HTTPIngressRuleValue{
-			Paths: []v1alpha1.HTTPIngressPath{{
-				Splits: splits,
-				// TODO(lichuqiang): #2201, plumbing to config timeout and retries.
-			}},
+			Paths: append(paths, path),
 		},
 	}
 }
+
+func makeHeaderMatchIngressPath(ns string, t traffic.RevisionTarget) *v1alpha1.HTTPIngressPath {
+	headers := make(map[string]v1alpha1.HeaderMatch, len(t.Headers))
+	for name, value := range t.Headers {
+		headers[name] = v1alpha1.HeaderMatch{Exact: value}
+	}
+
+	path := &v1alpha1.HTTPIngressPath{
+		Headers: headers,
+		Splits: []v1alpha1.IngressBackendSplit{{
+			IngressBackend: v1alpha1.IngressBackend{
+				ServiceNamespace: ns,
+				ServiceName:      t.ServiceName,
+				ServicePort:      intstr.FromInt(int(networking.ServicePort(t.Protocol))),
+			},
+			Percent: 100,
+			AppendHeaders: map[string]string{
+				activator.RevisionHeaderName:      t.TrafficTarget.RevisionName,
+				activator.RevisionHeaderNamespace: ns,
+			},
+		}},
+	}
+	if t.TimeoutSeconds > 0 {
+		path.Timeout = &metav1.Duration{Duration: time.Duration(t.TimeoutSeconds) * time.Second}
+	}
+	return path
+}
+
+// makeIngressRetries translates the Route's retry policy into the form the
+// Ingress understands.
+func makeIngressRetries(rr *servingv1.RouteRetries) *v1alpha1.HTTPRetry {
+	if rr == nil {
+		return nil
+	}
+	retry := &v1alpha1.HTTPRetry{
+		Attempts:             rr.Attempts,
+		RetriableStatusCodes: rr.RetriableStatusCodes,
+	}
+	if rr.PerTryTimeoutSeconds != nil {
+		retry.PerTryTimeout = &metav1.Duration{Duration: time.Duration(*rr.PerTryTimeoutSeconds) * time.Second}
+	}
+	return retry
+}
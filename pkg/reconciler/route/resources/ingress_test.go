@@ -20,6 +20,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -318,7 +319,7 @@ func TestMakeIngressRule_Vanilla(t *testing.T) {
 		Active:      true,
 	}}
 	domains := []string{"a.com", "b.org"}
-	rule := makeIngressRule(domains, ns, netv1alpha1.IngressVisibilityExternalIP, targets)
+	rule := makeIngressRule(domains, Route(ns, "test-route"), netv1alpha1.IngressVisibilityExternalIP, targets)
 	expected := netv1alpha1.IngressRule{
 		Hosts: []string{
 			"a.com",
@@ -348,6 +349,187 @@ func TestMakeIngressRule_Vanilla(t *testing.T) {
 	}
 }
 
+// The path timeout covers the slowest revision being routed to.
+func TestMakeIngressRule_Timeout(t *testing.T) {
+	targets := []traffic.RevisionTarget{{
+		TrafficTarget: v1.TrafficTarget{
+			ConfigurationName: "config",
+			RevisionName:      "revision",
+			Percent:           ptr.Int64(90),
+		},
+		ServiceName:    "slow",
+		Active:         true,
+		TimeoutSeconds: 600,
+	}, {
+		TrafficTarget: v1.TrafficTarget{
+			ConfigurationName: "new-config",
+			RevisionName:      "new-revision",
+			Percent:           ptr.Int64(10),
+		},
+		ServiceName:    "fast",
+		Active:         true,
+		TimeoutSeconds: 60,
+	}}
+	domains := []string{"test.org"}
+	rule := makeIngressRule(domains, Route(ns, "test-route"), netv1alpha1.IngressVisibilityExternalIP, targets)
+	expected := netv1alpha1.IngressRule{
+		Hosts: []string{"test.org"},
+		HTTP: &netv1alpha1.HTTPIngressRuleValue{
+			Paths: []netv1alpha1.HTTPIngressPath{{
+				Splits: []netv1alpha1.IngressBackendSplit{{
+					IngressBackend: netv1alpha1.IngressBackend{
+						ServiceNamespace: ns,
+						ServiceName:      "slow",
+						ServicePort:      intstr.FromInt(80),
+					},
+					Percent: 90,
+					AppendHeaders: map[string]string{
+						"Knative-Serving-Revision":  "revision",
+						"Knative-Serving-Namespace": ns,
+					},
+				}, {
+					IngressBackend: netv1alpha1.IngressBackend{
+						ServiceNamespace: ns,
+						ServiceName:      "fast",
+						ServicePort:      intstr.FromInt(80),
+					},
+					Percent: 10,
+					AppendHeaders: map[string]string{
+						"Knative-Serving-Revision":  "new-revision",
+						"Knative-Serving-Namespace": ns,
+					},
+				}},
+				Timeout: &metav1.Duration{Duration: 600 * time.Second},
+			}},
+		},
+		Visibility: netv1alpha1.IngressVisibilityExternalIP,
+	}
+
+	if !cmp.Equal(&expected, rule) {
+		t.Errorf("Unexpected rule (-want, +got): %s", cmp.Diff(&expected, rule))
+	}
+}
+
+// Retry and timeout policies on the Route are applied to the path.
+func TestMakeIngressRule_RetriesAndTimeout(t *testing.T) {
+	targets := []traffic.RevisionTarget{{
+		TrafficTarget: v1.TrafficTarget{
+			ConfigurationName: "config",
+			RevisionName:      "revision",
+			Percent:           ptr.Int64(100),
+		},
+		ServiceName:    "chocolate",
+		Active:         true,
+		TimeoutSeconds: 600,
+	}}
+	r := Route(ns, "test-route")
+	r.Spec.Retries = &v1.RouteRetries{
+		Attempts:             3,
+		PerTryTimeoutSeconds: ptr.Int64(10),
+		RetriableStatusCodes: []int{503},
+	}
+	r.Spec.TimeoutSeconds = ptr.Int64(30)
+	domains := []string{"test.org"}
+	rule := makeIngressRule(domains, r, netv1alpha1.IngressVisibilityExternalIP, targets)
+	expected := netv1alpha1.IngressRule{
+		Hosts: []string{"test.org"},
+		HTTP: &netv1alpha1.HTTPIngressRuleValue{
+			Paths: []netv1alpha1.HTTPIngressPath{{
+				Splits: []netv1alpha1.IngressBackendSplit{{
+					IngressBackend: netv1alpha1.IngressBackend{
+						ServiceNamespace: ns,
+						ServiceName:      "chocolate",
+						ServicePort:      intstr.FromInt(80),
+					},
+					Percent: 100,
+					AppendHeaders: map[string]string{
+						"Knative-Serving-Revision":  "revision",
+						"Knative-Serving-Namespace": ns,
+					},
+				}},
+				Retries: &netv1alpha1.HTTPRetry{
+					Attempts:             3,
+					PerTryTimeout:        &metav1.Duration{Duration: 10 * time.Second},
+					RetriableStatusCodes: []int{503},
+				},
+				// The Route's timeout wins over the Revision's.
+				Timeout: &metav1.Duration{Duration: 30 * time.Second},
+			}},
+		},
+		Visibility: netv1alpha1.IngressVisibilityExternalIP,
+	}
+
+	if !cmp.Equal(&expected, rule) {
+		t.Errorf("Unexpected rule (-want, +got): %s", cmp.Diff(&expected, rule))
+	}
+}
+
+// A fully rolled out target plus a dark-launched candidate matched on a header.
+func TestMakeIngressRule_HeaderMatch(t *testing.T) {
+	targets := []traffic.RevisionTarget{{
+		TrafficTarget: v1.TrafficTarget{
+			ConfigurationName: "config",
+			RevisionName:      "revision",
+			Percent:           ptr.Int64(100),
+		},
+		ServiceName: "stable",
+		Active:      true,
+	}, {
+		TrafficTarget: v1.TrafficTarget{
+			ConfigurationName: "new-config",
+			RevisionName:      "new-revision",
+			Percent:           ptr.Int64(0),
+			Headers: map[string]string{
+				"Knative-Candidate": "yes",
+			},
+		},
+		ServiceName: "candidate",
+		Active:      true,
+	}}
+	domains := []string{"test.org"}
+	rule := makeIngressRule(domains, Route(ns, "test-route"), netv1alpha1.IngressVisibilityExternalIP, targets)
+	expected := netv1alpha1.IngressRule{
+		Hosts: []string{"test.org"},
+		HTTP: &netv1alpha1.HTTPIngressRuleValue{
+			Paths: []netv1alpha1.HTTPIngressPath{{
+				Headers: map[string]netv1alpha1.HeaderMatch{
+					"Knative-Candidate": {Exact: "yes"},
+				},
+				Splits: []netv1alpha1.IngressBackendSplit{{
+					IngressBackend: netv1alpha1.IngressBackend{
+						ServiceNamespace: ns,
+						ServiceName:      "candidate",
+						ServicePort:      intstr.FromInt(80),
+					},
+					Percent: 100,
+					AppendHeaders: map[string]string{
+						"Knative-Serving-Revision":  "new-revision",
+						"Knative-Serving-Namespace": ns,
+					},
+				}},
+			}, {
+				Splits: []netv1alpha1.IngressBackendSplit{{
+					IngressBackend: netv1alpha1.IngressBackend{
+						ServiceNamespace: ns,
+						ServiceName:      "stable",
+						ServicePort:      intstr.FromInt(80),
+					},
+					Percent: 100,
+					AppendHeaders: map[string]string{
+						"Knative-Serving-Revision":  "revision",
+						"Knative-Serving-Namespace": ns,
+					},
+				}},
+			}},
+		},
+		Visibility: netv1alpha1.IngressVisibilityExternalIP,
+	}
+
+	if !cmp.Equal(&expected, rule) {
+		t.Errorf("Unexpected rule (-want, +got): %s", cmp.Diff(&expected, rule))
+	}
+}
+
 // One active target and a target of zero percent.
 func TestMakeIngressRule_ZeroPercentTarget(t *testing.T) {
 	targets := []traffic.RevisionTarget{{
@@ -367,7 +549,7 @@ func TestMakeIngressRule_ZeroPercentTarget(t *testing.T) {
 		Active: true,
 	}}
 	domains := []string{"test.org"}
-	rule := makeIngressRule(domains, ns, netv1alpha1.IngressVisibilityExternalIP, targets)
+	rule := makeIngressRule(domains, Route(ns, "test-route"), netv1alpha1.IngressVisibilityExternalIP, targets)
 	expected := netv1alpha1.IngressRule{
 		Hosts: []string{"test.org"},
 		HTTP: &netv1alpha1.HTTPIngressRuleValue{
@@ -413,7 +595,7 @@ func TestMakeIngressRule_NilPercentTarget(t *testing.T) {
 		Active: true,
 	}}
 	domains := []string{"test.org"}
-	rule := makeIngressRule(domains, ns, netv1alpha1.IngressVisibilityExternalIP, targets)
+	rule := makeIngressRule(domains, Route(ns, "test-route"), netv1alpha1.IngressVisibilityExternalIP, targets)
 	expected := netv1alpha1.IngressRule{
 		Hosts: []string{"test.org"},
 		HTTP: &netv1alpha1.HTTPIngressRuleValue{
@@ -460,7 +642,7 @@ func TestMakeIngressRule_TwoTargets(t *testing.T) {
 		Active:      true,
 	}}
 	domains := []string{"test.org"}
-	rule := makeIngressRule(domains, ns, netv1alpha1.IngressVisibilityExternalIP, targets)
+	rule := makeIngressRule(domains, Route(ns, "test-route"), netv1alpha1.IngressVisibilityExternalIP, targets)
 	expected := netv1alpha1.IngressRule{
 		Hosts: []string{"test.org"},
 		HTTP: &netv1alpha1.HTTPIngressRuleValue{
@@ -510,7 +692,7 @@ func TestMakeIngressRule_InactiveTarget(t *testing.T) {
 		Active:      false,
 	}}
 	domains := []string{"a.com", "b.org"}
-	rule := makeIngressRule(domains, ns, netv1alpha1.IngressVisibilityExternalIP, targets)
+	rule := makeIngressRule(domains, Route(ns, "test-route"), netv1alpha1.IngressVisibilityExternalIP, targets)
 	expected := netv1alpha1.IngressRule{
 		Hosts: []string{
 			"a.com",
@@ -559,7 +741,7 @@ func TestMakeIngressRule_TwoInactiveTargets(t *testing.T) {
 		Active:      false,
 	}}
 	domains := []string{"a.com", "b.org"}
-	rule := makeIngressRule(domains, ns, netv1alpha1.IngressVisibilityExternalIP, targets)
+	rule := makeIngressRule(domains, Route(ns, "test-route"), netv1alpha1.IngressVisibilityExternalIP, targets)
 	expected := netv1alpha1.IngressRule{
 		Hosts: []string{
 			"a.com",
@@ -617,7 +799,7 @@ func TestMakeIngressRule_ZeroPercentTargetInactive(t *testing.T) {
 		Active: false,
 	}}
 	domains := []string{"test.org"}
-	rule := makeIngressRule(domains, ns, netv1alpha1.IngressVisibilityExternalIP, targets)
+	rule := makeIngressRule(domains, Route(ns, "test-route"), netv1alpha1.IngressVisibilityExternalIP, targets)
 	expected := netv1alpha1.IngressRule{
 		Hosts: []string{"test.org"},
 		HTTP: &netv1alpha1.HTTPIngressRuleValue{
@@ -662,7 +844,7 @@ func TestMakeIngressRule_NilPercentTargetInactive(t *testing.T) {
 		Active: false,
 	}}
 	domains := []string{"test.org"}
-	rule := makeIngressRule(domains, ns, netv1alpha1.IngressVisibilityExternalIP, targets)
+	rule := makeIngressRule(domains, Route(ns, "test-route"), netv1alpha1.IngressVisibilityExternalIP, targets)
 	expected := netv1alpha1.IngressRule{
 		Hosts: []string{"test.org"},
 		HTTP: &netv1alpha1.HTTPIngressRuleValue{
@@ -18,12 +18,15 @@ package labels
 
 import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/serving/pkg/apis/networking"
 	"knative.dev/serving/pkg/apis/serving"
 )
 
 // IsObjectLocalVisibility returns whether an ObjectMeta is of cluster-local visibility
 func IsObjectLocalVisibility(meta v1.ObjectMeta) bool {
-	return meta.Labels != nil && meta.Labels[serving.VisibilityLabelKey] != ""
+	return meta.Labels != nil &&
+		(meta.Labels[serving.VisibilityLabelKey] != "" ||
+			meta.Labels[networking.VisibilityLabelKey] == serving.VisibilityClusterLocal)
 }
 
 // SetVisibility sets the visibility on an ObjectMeta
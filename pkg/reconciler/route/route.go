@@ -20,6 +20,7 @@ import (
 	"context"
 	"sort"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	kubelabels "k8s.io/apimachinery/pkg/labels"
@@ -35,6 +36,7 @@ import (
 	"knative.dev/pkg/tracker"
 	"knative.dev/serving/pkg/apis/networking"
 	netv1alpha1 "knative.dev/serving/pkg/apis/networking/v1alpha1"
+	"knative.dev/serving/pkg/apis/serving"
 	v1 "knative.dev/serving/pkg/apis/serving/v1"
 	clientset "knative.dev/serving/pkg/client/clientset/versioned"
 	routereconciler "knative.dev/serving/pkg/client/injection/reconciler/serving/v1/route"
@@ -50,8 +52,13 @@ import (
 	resourcenames "knative.dev/serving/pkg/reconciler/route/resources/names"
 	"knative.dev/serving/pkg/reconciler/route/traffic"
 	"knative.dev/serving/pkg/reconciler/route/visibility"
+	presources "knative.dev/serving/pkg/resources"
 )
 
+// rolloutResyncInterval is how often an in-progress gradual rollout is
+// re-evaluated to shift the next increment of traffic.
+const rolloutResyncInterval = 5 * time.Second
+
 // Reconciler implements controller.Reconciler for Route resources.
 type Reconciler struct {
 	kubeclient kubernetes.Interface
@@ -66,6 +73,10 @@ type Reconciler struct {
 	tracker             tracker.Interface
 
 	clock system.Clock
+
+	// enqueueAfter re-queues the Route so that in-progress gradual
+	// rollouts keep making progress between watch events.
+	enqueueAfter func(interface{}, time.Duration)
 }
 
 // Check that our Reconciler implements routereconciler.Interface
@@ -111,8 +122,17 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, r *v1.Route) pkgreconcil
 
 	logger.Infof("Reconciling route: %#v", r)
 
+	// Remember the traffic assignment the data path was last verified to be
+	// serving, so that we keep advertising it until the new programming is live.
+	verifiedTraffic := r.Status.Traffic
+
 	// Configure traffic based on the RouteSpec.
 	traffic, err := c.configureTraffic(ctx, r)
+	if traffic != nil && traffic.InRollout && c.enqueueAfter != nil {
+		// A gradual rollout is underway; check back shortly to shift
+		// the next increment of traffic.
+		c.enqueueAfter(r, rolloutResyncInterval)
+	}
 	if traffic == nil || err != nil {
 		// Traffic targets aren't ready, no need to configure child resources.
 		// Need to update ObservedGeneration, otherwise Route's Ready state won't
@@ -156,6 +176,9 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, r *v1.Route) pkgreconcil
 
 	if ingress.GetObjectMeta().GetGeneration() != ingress.Status.ObservedGeneration || !ingress.Status.IsReady() {
 		r.Status.MarkIngressNotConfigured()
+		// The ingress hasn't programmed the new assignment yet, so only report
+		// the traffic the data path was last verified to be serving.
+		r.Status.Traffic = verifiedTraffic
 	} else {
 		r.Status.PropagateIngressStatus(ingress.Status)
 	}
@@ -202,7 +225,8 @@ func (c *Reconciler) tls(ctx context.Context, host string, r *v1.Route, traffic
 		}
 	}
 
-	routeDomain := config.FromContext(ctx).Domain.LookupDomainForLabels(r.Labels)
+	routeDomain := config.FromContext(ctx).Domain.LookupDomainForLabels(presources.UnionMaps(
+		r.Labels, map[string]string{serving.RouteNamespaceLabelKey: r.Namespace}))
 	labelSelector := kubelabels.SelectorFromSet(kubelabels.Set{
 		networking.WildcardCertDomainLabelKey: routeDomain,
 	})
@@ -274,7 +298,7 @@ func (c *Reconciler) tls(ctx context.Context, host string, r *v1.Route, traffic
 // mark AllTrafficAssigned = False, with a message referring to one of the missing target.
 func (c *Reconciler) configureTraffic(ctx context.Context, r *v1.Route) (*traffic.Config, error) {
 	logger := logging.FromContext(ctx)
-	t, trafficErr := traffic.BuildTrafficConfiguration(c.configurationLister, c.revisionLister, r)
+	t, trafficErr := traffic.BuildTrafficConfiguration(ctx, c.configurationLister, c.revisionLister, r)
 	if t == nil {
 		return nil, trafficErr
 	}
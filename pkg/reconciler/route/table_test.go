@@ -60,7 +60,7 @@ import (
 
 const TestIngressClass = "ingress-class-foo"
 
-var fakeCurTime = time.Unix(1e9, 0)
+var fakeCurTime = FakeTime
 
 // This is heavily based on the way the OpenShift Ingress controller tests its reconciliation method.
 func TestReconcile(t *testing.T) {
@@ -91,7 +91,7 @@ func TestReconcile(t *testing.T) {
 		Objects: []runtime.Object{
 			Route("default", "first-reconcile", WithConfigTarget("permanently-failed")),
 			cfg("default", "permanently-failed",
-				WithGeneration(1), WithLatestCreated("permanently-failed-00001"), MarkLatestCreatedFailed("blah")),
+				WithGeneration(1), WithLatestCreated("permanently-failed-00001"), MarkLatestCreatedFailed("RevisionFailed", "blah")),
 			rev("default", "permanently-failed", 1,
 				WithRevName("permanently-failed-00001"),
 				WithInitRevConditions, MarkContainerMissing),
@@ -157,13 +157,9 @@ func TestReconcile(t *testing.T) {
 			Object: Route("default", "becomes-ready", WithConfigTarget("config"),
 				WithRouteUID("12-34"),
 				// Populated by reconciliation when all traffic has been assigned.
+				// Status traffic is withheld until the ingress is verified ready.
 				WithURL, WithAddress, WithInitRouteConditions,
-				MarkTrafficAssigned, MarkIngressNotConfigured, WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "config-00001",
-						Percent:        ptr.Int64(100),
-						LatestRevision: ptr.Bool(true),
-					})),
+				MarkTrafficAssigned, MarkIngressNotConfigured),
 		}},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeNormal, "Created", "Created placeholder service %q", "becomes-ready"),
@@ -208,13 +204,9 @@ func TestReconcile(t *testing.T) {
 			Object: Route("default", "becomes-ready", WithConfigTarget("config"),
 				WithRouteUID("12-34"), WithIngressClass("custom-ingress-class"),
 				// Populated by reconciliation when all traffic has been assigned.
+				// Status traffic is withheld until the ingress is verified ready.
 				WithURL, WithAddress, WithInitRouteConditions,
-				MarkTrafficAssigned, MarkIngressNotConfigured, WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "config-00001",
-						Percent:        ptr.Int64(100),
-						LatestRevision: ptr.Bool(true),
-					})),
+				MarkTrafficAssigned, MarkIngressNotConfigured),
 		}},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeNormal, "Created", "Created placeholder service %q", "becomes-ready"),
@@ -267,12 +259,7 @@ func TestReconcile(t *testing.T) {
 				// Populated by reconciliation when all traffic has been assigned.
 				WithLocalDomain, WithAddress, WithInitRouteConditions,
 				WithRouteLabel(map[string]string{"serving.knative.dev/visibility": "cluster-local"}),
-				MarkTrafficAssigned, MarkIngressNotConfigured, WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "config-00001",
-						Percent:        ptr.Int64(100),
-						LatestRevision: ptr.Bool(true),
-					})),
+				MarkTrafficAssigned, MarkIngressNotConfigured),
 		}},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeNormal, "Created", "Created placeholder service %q", "becomes-ready"),
@@ -715,13 +702,7 @@ func TestReconcile(t *testing.T) {
 				WithRouteUID("65-23"),
 				MarkTrafficAssigned, MarkIngressNotConfigured,
 				WithLocalDomain, WithAddress, WithInitRouteConditions,
-				WithRouteLabel(map[string]string{"serving.knative.dev/visibility": "cluster-local"}),
-				WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "config-00001",
-						Percent:        ptr.Int64(100),
-						LatestRevision: ptr.Bool(true),
-					})),
+				WithRouteLabel(map[string]string{"serving.knative.dev/visibility": "cluster-local"})),
 		}},
 		Key: "default/becomes-local",
 	}, {
@@ -778,13 +759,7 @@ func TestReconcile(t *testing.T) {
 			Object: Route("default", "becomes-public", WithConfigTarget("config"),
 				WithRouteUID("65-23"),
 				MarkTrafficAssigned, MarkIngressNotConfigured,
-				WithAddress, WithInitRouteConditions, WithURL,
-				WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "config-00001",
-						Percent:        ptr.Int64(100),
-						LatestRevision: ptr.Bool(true),
-					})),
+				WithAddress, WithInitRouteConditions, WithURL),
 		}},
 		Key: "default/becomes-public",
 	}, {
@@ -1307,16 +1282,7 @@ func TestReconcile(t *testing.T) {
 					Percent:           ptr.Int64(50),
 				}), WithRouteUID("34-78"),
 				WithURL, WithAddress, WithInitRouteConditions,
-				MarkTrafficAssigned, MarkIngressNotConfigured, WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "blue-00001",
-						Percent:        ptr.Int64(50),
-						LatestRevision: ptr.Bool(true),
-					}, v1.TrafficTarget{
-						RevisionName:   "green-00001",
-						Percent:        ptr.Int64(50),
-						LatestRevision: ptr.Bool(true),
-					})),
+				MarkTrafficAssigned, MarkIngressNotConfigured),
 		}},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeNormal, "Created", "Created placeholder service %q", "named-traffic-split"),
@@ -1444,27 +1410,7 @@ func TestReconcile(t *testing.T) {
 						Percent:      ptr.Int64(50),
 					}), WithRouteUID("1-2"), WithRouteFinalizer,
 				WithURL, WithAddress, WithInitRouteConditions,
-				MarkTrafficAssigned, MarkIngressNotConfigured, WithStatusTraffic(
-					v1.TrafficTarget{
-						Tag:            "gray",
-						RevisionName:   "gray-00001",
-						Percent:        ptr.Int64(50),
-						LatestRevision: ptr.Bool(true),
-						URL: &apis.URL{
-							Scheme: "http",
-							Host:   "gray-same-revision-targets.default.example.com",
-						},
-					},
-					v1.TrafficTarget{
-						Tag:            "also-gray",
-						RevisionName:   "gray-00001",
-						Percent:        ptr.Int64(50),
-						LatestRevision: ptr.Bool(false),
-						URL: &apis.URL{
-							Scheme: "http",
-							Host:   "also-gray-same-revision-targets.default.example.com",
-						},
-					})),
+				MarkTrafficAssigned, MarkIngressNotConfigured),
 		}},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeNormal, "Created", "Created placeholder service %q", "same-revision-targets"),
@@ -1773,7 +1719,7 @@ func TestReconcile(t *testing.T) {
 			serviceLister:       listers.GetK8sServiceLister(),
 			ingressLister:       listers.GetIngressLister(),
 			tracker:             ctx.Value(TrackerKey).(tracker.Interface),
-			clock:               FakeClock{Time: fakeCurTime},
+			clock:               GetClock(ctx),
 		}
 
 		return routereconciler.NewReconciler(ctx, logging.FromContext(ctx), servingclient.Get(ctx),
@@ -1826,12 +1772,8 @@ func TestReconcile_EnableAutoTLS(t *testing.T) {
 				WithRouteUID("12-34"),
 				// Populated by reconciliation when all traffic has been assigned.
 				WithAddress, WithInitRouteConditions,
-				MarkTrafficAssigned, MarkIngressNotConfigured, WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "config-00001",
-						Percent:        ptr.Int64(100),
-						LatestRevision: ptr.Bool(true),
-					}), WithReadyCertificateName("default.example.com"), WithHTTPSDomain),
+				MarkTrafficAssigned, MarkIngressNotConfigured,
+				WithReadyCertificateName("default.example.com"), WithHTTPSDomain),
 		}},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeNormal, "Created", "Created placeholder service %q", "becomes-ready"),
@@ -1878,12 +1820,7 @@ func TestReconcile_EnableAutoTLS(t *testing.T) {
 				WithRouteUID("12-34"),
 				// Populated by reconciliation when all traffic has been assigned.
 				WithURL, WithAddress, WithInitRouteConditions,
-				MarkTrafficAssigned, MarkIngressNotConfigured, WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "config-00001",
-						Percent:        ptr.Int64(100),
-						LatestRevision: ptr.Bool(true),
-					}), MarkCertificateNotReady),
+				MarkTrafficAssigned, MarkIngressNotConfigured, MarkCertificateNotReady),
 		}},
 		WantEvents: []string{
 			Eventf(corev1.EventTypeNormal, "Created", "Created placeholder service %q", "becomes-ready"),
@@ -1935,12 +1872,7 @@ func TestReconcile_EnableAutoTLS(t *testing.T) {
 				WithRouteUID("12-34"),
 				// Populated by reconciliation when all traffic has been assigned.
 				WithURL, WithAddress, WithInitRouteConditions,
-				MarkTrafficAssigned, MarkIngressNotConfigured, WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "config-00001",
-						Percent:        ptr.Int64(100),
-						LatestRevision: ptr.Bool(true),
-					}),
+				MarkTrafficAssigned, MarkIngressNotConfigured,
 				// The certificate is ready. So we want to have HTTPS URL.
 				MarkCertificateReady, WithHTTPSDomain),
 		}},
@@ -2015,12 +1947,7 @@ func TestReconcile_EnableAutoTLS(t *testing.T) {
 				WithRouteUID("12-34"),
 				// Populated by reconciliation when all traffic has been assigned.
 				WithAddress, WithInitRouteConditions,
-				MarkTrafficAssigned, MarkIngressNotConfigured, WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "config-00001",
-						Percent:        ptr.Int64(100),
-						LatestRevision: ptr.Bool(true),
-					}), MarkCertificateReady,
+				MarkTrafficAssigned, MarkIngressNotConfigured, MarkCertificateReady,
 				// The certificate is ready. So we want to have HTTPS URL.
 				WithHTTPSDomain),
 		}},
@@ -2111,12 +2038,7 @@ func TestReconcile_EnableAutoTLS(t *testing.T) {
 				WithRouteUID("12-34"),
 				// Populated by reconciliation when all traffic has been assigned.
 				WithAddress, WithInitRouteConditions,
-				MarkTrafficAssigned, MarkIngressNotConfigured, WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "config-00001",
-						Percent:        ptr.Int64(100),
-						LatestRevision: ptr.Bool(true),
-					}),
+				MarkTrafficAssigned, MarkIngressNotConfigured,
 				// The certificate has to be created in the not ready state for the ACME challenge
 				// ingress rules to be added.
 				MarkCertificateNotReady,
@@ -2234,12 +2156,8 @@ func TestReconcile_EnableAutoTLS(t *testing.T) {
 				WithRouteUID("12-34"),
 				// Populated by reconciliation when all traffic has been assigned.
 				WithAddress, WithInitRouteConditions,
-				MarkTrafficAssigned, MarkIngressNotConfigured, WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "config-00001",
-						Percent:        ptr.Int64(100),
-						LatestRevision: ptr.Bool(true),
-					}), MarkCertificateNotReady, MarkIngressNotConfigured,
+				MarkTrafficAssigned, MarkIngressNotConfigured,
+				MarkCertificateNotReady, MarkIngressNotConfigured,
 				// The certificate is not ready. So we want to have HTTP URL.
 				WithURL),
 		}},
@@ -2307,13 +2225,7 @@ func TestReconcile_EnableAutoTLS(t *testing.T) {
 				WithRouteUID("65-23"),
 				MarkTrafficAssigned, MarkIngressNotConfigured,
 				WithLocalDomain, WithAddress, WithInitRouteConditions,
-				WithRouteLabel(map[string]string{serving.VisibilityLabelKey: serving.VisibilityClusterLocal}),
-				WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "config-00001",
-						Percent:        ptr.Int64(100),
-						LatestRevision: ptr.Bool(true),
-					})),
+				WithRouteLabel(map[string]string{serving.VisibilityLabelKey: serving.VisibilityClusterLocal})),
 		}},
 		Key: "default/becomes-local",
 	}}
@@ -2327,7 +2239,7 @@ func TestReconcile_EnableAutoTLS(t *testing.T) {
 			ingressLister:       listers.GetIngressLister(),
 			certificateLister:   listers.GetCertificateLister(),
 			tracker:             &NullTracker{},
-			clock:               FakeClock{Time: fakeCurTime},
+			clock:               GetClock(ctx),
 		}
 
 		return routereconciler.NewReconciler(ctx, logging.FromContext(ctx), servingclient.Get(ctx),
@@ -2418,12 +2330,8 @@ func TestReconcile_EnableAutoTLS_HTTPDisabled(t *testing.T) {
 				WithRouteUID("12-34"),
 				// Populated by reconciliation when all traffic has been assigned.
 				WithAddress, WithInitRouteConditions,
-				MarkTrafficAssigned, MarkIngressNotConfigured, WithStatusTraffic(
-					v1.TrafficTarget{
-						RevisionName:   "config-00001",
-						Percent:        ptr.Int64(100),
-						LatestRevision: ptr.Bool(true),
-					}), MarkCertificateNotReady, MarkIngressNotConfigured,
+				MarkTrafficAssigned, MarkIngressNotConfigured,
+				MarkCertificateNotReady, MarkIngressNotConfigured,
 				// The certificate is not ready. But we still want to have HTTPS URL.
 				WithHTTPSDomain),
 		}},
@@ -2446,7 +2354,7 @@ func TestReconcile_EnableAutoTLS_HTTPDisabled(t *testing.T) {
 			ingressLister:       listers.GetIngressLister(),
 			certificateLister:   listers.GetCertificateLister(),
 			tracker:             &NullTracker{},
-			clock:               FakeClock{Time: fakeCurTime},
+			clock:               GetClock(ctx),
 		}
 
 		return routereconciler.NewReconciler(ctx, logging.FromContext(ctx), servingclient.Get(ctx),
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package traffic
+
+import (
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	v1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// rolloutTargets decides whether the given configuration target is still
+// within its rollout window and, if so, splits it between the Revision the
+// Route was previously serving and the new latest ready Revision.  The new
+// Revision's share grows linearly with the time elapsed since it became
+// ready, so the rollout needs no state beyond what the Route and Revisions
+// already carry.
+func (t *configBuilder) rolloutTargets(target RevisionTarget, rev *v1.Revision) (prev, cur RevisionTarget, ok bool) {
+	if t.rolloutDuration <= 0 || target.Percent == nil || *target.Percent == 0 {
+		return prev, cur, false
+	}
+
+	prevName := t.previousRevisionName(target, rev.Name)
+	if prevName == "" {
+		return prev, cur, false
+	}
+
+	cond := rev.Status.GetCondition(v1.RevisionConditionReady)
+	if cond == nil {
+		return prev, cur, false
+	}
+	elapsed := t.now.Sub(cond.LastTransitionTime.Inner.Time)
+	if elapsed >= t.rolloutDuration {
+		return prev, cur, false
+	}
+
+	// If the previous Revision is gone or no longer ready there is nothing
+	// to shift from, so the new Revision takes all the traffic at once.
+	prevRev, err := t.getRevision(prevName)
+	if err != nil || !prevRev.Status.IsReady() {
+		return prev, cur, false
+	}
+
+	curPercent := *target.Percent * int64(elapsed) / int64(t.rolloutDuration)
+	prevPercent := *target.Percent - curPercent
+	if prevPercent <= 0 {
+		return prev, cur, false
+	}
+
+	cur = target
+	cur.TrafficTarget.Percent = ptr.Int64(curPercent)
+
+	prev = RevisionTarget{
+		TrafficTarget: v1.TrafficTarget{
+			Tag:               target.Tag,
+			RevisionName:      prevRev.Name,
+			ConfigurationName: target.ConfigurationName,
+			LatestRevision:    ptr.Bool(false),
+			Percent:           ptr.Int64(prevPercent),
+		},
+		Active:      !prevRev.Status.IsActivationRequired(),
+		Protocol:    prevRev.GetProtocol(),
+		ServiceName: prevRev.Status.ServiceName,
+	}
+	if prevRev.Spec.TimeoutSeconds != nil {
+		prev.TimeoutSeconds = *prevRev.Spec.TimeoutSeconds
+	}
+
+	t.inRollout = true
+	return prev, cur, true
+}
+
+// previousRevisionName looks up the Revision the Route's status reports for
+// the given target's tag, which is the Revision the configuration target was
+// serving before its latest ready Revision changed.  Revisions pinned by the
+// spec or belonging to other Configurations don't count.
+func (t *configBuilder) previousRevisionName(target RevisionTarget, latestName string) string {
+	for _, tt := range t.route.Status.Traffic {
+		if tt.Tag != target.Tag || tt.RevisionName == latestName || tt.RevisionName == "" {
+			continue
+		}
+		if t.isPinnedInSpec(target.Tag, tt.RevisionName) {
+			continue
+		}
+		rev, err := t.revLister.Revisions(t.namespace).Get(tt.RevisionName)
+		if err != nil || rev.Labels[serving.ConfigurationLabelKey] != target.ConfigurationName {
+			continue
+		}
+		return tt.RevisionName
+	}
+	return ""
+}
+
+// isPinnedInSpec checks whether the Route's spec explicitly routes traffic to
+// the given Revision under the given tag.
+func (t *configBuilder) isPinnedInSpec(tag, revName string) bool {
+	for _, tt := range t.route.Spec.Traffic {
+		if tt.Tag == tag && tt.RevisionName == revName {
+			return true
+		}
+	}
+	return false
+}
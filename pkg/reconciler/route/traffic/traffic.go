@@ -18,6 +18,9 @@ package traffic
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -28,6 +31,7 @@ import (
 	"knative.dev/serving/pkg/apis/serving"
 	v1 "knative.dev/serving/pkg/apis/serving/v1"
 	listers "knative.dev/serving/pkg/client/listers/serving/v1"
+	"knative.dev/serving/pkg/reconciler/route/config"
 	"knative.dev/serving/pkg/reconciler/route/domains"
 	"knative.dev/serving/pkg/reconciler/route/resources/labels"
 )
@@ -41,9 +45,10 @@ const (
 // Revision to a flattened TrafficTarget.
 type RevisionTarget struct {
 	v1.TrafficTarget
-	Active      bool
-	Protocol    net.ProtocolType
-	ServiceName string // Revision service name.
+	Active         bool
+	Protocol       net.ProtocolType
+	ServiceName    string // Revision service name.
+	TimeoutSeconds int64  // Revision request timeout.
 }
 
 // RevisionTargets is a collection of revision targets.
@@ -72,6 +77,11 @@ type Config struct {
 	// MissingTargets are references to Configuration's or Revision's
 	// that are missing
 	MissingTargets []corev1.ObjectReference
+
+	// InRollout is true when some configuration target is still being
+	// gradually shifted to its new latest ready Revision, so the Route
+	// needs to be reconciled again to make further progress.
+	InRollout bool
 }
 
 // BuildTrafficConfiguration consolidates and flattens the Route.Spec.Traffic to the Revision-level. It also provides a
@@ -79,13 +89,43 @@ type Config struct {
 // are keyed by name for easy access.
 //
 // In the case that some target is missing, an error of type TargetError will be returned.
-func BuildTrafficConfiguration(configLister listers.ConfigurationLister, revLister listers.RevisionLister,
+func BuildTrafficConfiguration(ctx context.Context, configLister listers.ConfigurationLister, revLister listers.RevisionLister,
 	r *v1.Route) (*Config, error) {
-	builder := newBuilder(configLister, revLister, r.Namespace, len(r.Spec.Traffic))
+	return buildTrafficConfiguration(ctx, configLister, revLister, r, time.Now())
+}
+
+func buildTrafficConfiguration(ctx context.Context, configLister listers.ConfigurationLister, revLister listers.RevisionLister,
+	r *v1.Route, now time.Time) (*Config, error) {
+	builder := newBuilder(configLister, revLister, r, len(r.Spec.Traffic))
+	rd, err := rolloutDuration(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	builder.rolloutDuration = rd
+	builder.now = now
 	builder.applySpecTraffic(r.Spec.Traffic)
 	return builder.build()
 }
 
+// rolloutDuration returns the duration over which to shift traffic to a new
+// latest ready Revision, preferring the Route's annotation over the
+// cluster-wide default in config-network.
+func rolloutDuration(ctx context.Context, r *v1.Route) (time.Duration, error) {
+	duration := time.Duration(0)
+	if cfg := config.FromContext(ctx); cfg != nil && cfg.Network != nil {
+		duration = cfg.Network.RolloutDuration
+	}
+	if v, ok := r.Annotations[serving.RolloutDurationAnnotationKey]; ok {
+		seconds, err := strconv.Atoi(v)
+		if err != nil || seconds < 0 {
+			return 0, fmt.Errorf("invalid %s annotation %q: must be a non-negative number of seconds",
+				serving.RolloutDurationAnnotationKey, v)
+		}
+		duration = time.Duration(seconds) * time.Second
+	}
+	return duration, nil
+}
+
 // GetRevisionTrafficTargets returns a list of TrafficTarget flattened to the RevisionName, and having ConfigurationName cleared out.
 func (t *Config) GetRevisionTrafficTargets(ctx context.Context, r *v1.Route) ([]v1.TrafficTarget, error) {
 	results := make([]v1.TrafficTarget, len(t.revisionTargets))
@@ -129,6 +169,19 @@ type configBuilder struct {
 	revLister    listers.RevisionLister
 	namespace    string
 
+	// route is the Route being reconciled; its status tells us which
+	// Revision a configuration target was previously serving.
+	route *v1.Route
+	// rolloutDuration is the window over which traffic is shifted to a
+	// configuration target's new latest ready Revision.  Zero disables
+	// gradual rollouts.
+	rolloutDuration time.Duration
+	// now is the time at which rollout progress is evaluated.
+	now time.Time
+	// inRollout is set when some configuration target was split because
+	// its rollout hasn't completed yet.
+	inRollout bool
+
 	// targets is a grouping of traffic targets serving the same origin.
 	targets map[string]RevisionTargets
 
@@ -150,11 +203,12 @@ type configBuilder struct {
 
 func newBuilder(
 	configLister listers.ConfigurationLister, revLister listers.RevisionLister,
-	namespace string, trafficSize int) *configBuilder {
+	r *v1.Route, trafficSize int) *configBuilder {
 	return &configBuilder{
 		configLister:    configLister,
 		revLister:       revLister,
-		namespace:       namespace,
+		namespace:       r.Namespace,
+		route:           r,
 		targets:         make(map[string]RevisionTargets),
 		revisionTargets: make(RevisionTargets, 0, trafficSize),
 
@@ -256,7 +310,15 @@ func (t *configBuilder) addConfigurationTarget(tt *v1.TrafficTarget) error {
 		Protocol:      rev.GetProtocol(),
 		ServiceName:   rev.Status.ServiceName,
 	}
+	if rev.Spec.TimeoutSeconds != nil {
+		target.TimeoutSeconds = *rev.Spec.TimeoutSeconds
+	}
 	target.TrafficTarget.RevisionName = rev.Name
+	if prev, cur, ok := t.rolloutTargets(target, rev); ok {
+		t.addFlattenedTarget(cur)
+		t.addFlattenedTarget(prev)
+		return nil
+	}
 	t.addFlattenedTarget(target)
 	return nil
 }
@@ -276,6 +338,9 @@ func (t *configBuilder) addRevisionTarget(tt *v1.TrafficTarget) error {
 		Protocol:      rev.GetProtocol(),
 		ServiceName:   rev.Status.ServiceName,
 	}
+	if rev.Spec.TimeoutSeconds != nil {
+		target.TimeoutSeconds = *rev.Spec.TimeoutSeconds
+	}
 	if configName, ok := rev.Labels[serving.ConfigurationLabelKey]; ok {
 		target.TrafficTarget.ConfigurationName = configName
 		if _, err := t.getConfiguration(configName); err != nil {
@@ -322,6 +387,35 @@ func consolidate(targets RevisionTargets) RevisionTargets {
 	}
 	if len(consolidated) == 1 {
 		consolidated[0].TrafficTarget.Percent = ptr.Int64(100)
+		return consolidated
+	}
+	var total int64
+	for _, tt := range consolidated {
+		if tt.TrafficTarget.Percent != nil {
+			total += *tt.TrafficTarget.Percent
+		}
+	}
+	if total > 0 && total != 100 {
+		// A gradual rollout splits a tagged target between two Revisions
+		// whose shares sum to the target's own percent; rescale them so
+		// the tag's dedicated hostname still splits to a full 100.
+		var rescaled int64
+		for i := range consolidated {
+			if p := consolidated[i].TrafficTarget.Percent; p != nil {
+				scaled := *p * 100 / total
+				consolidated[i].TrafficTarget.Percent = ptr.Int64(scaled)
+				rescaled += scaled
+			}
+		}
+		// Give any integer-division remainder to the first target.
+		if leftover := 100 - rescaled; leftover != 0 {
+			for i := range consolidated {
+				if p := consolidated[i].TrafficTarget.Percent; p != nil {
+					consolidated[i].TrafficTarget.Percent = ptr.Int64(*p + leftover)
+					break
+				}
+			}
+		}
 	}
 	return consolidated
 }
@@ -345,5 +439,6 @@ func (t *configBuilder) build() (*Config, error) {
 		Configurations:  t.configurations,
 		Revisions:       t.revisions,
 		MissingTargets:  t.missingTargets,
+		InRollout:       t.inRollout,
 	}, t.deferredTargetErr
 }
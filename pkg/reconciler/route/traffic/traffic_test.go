@@ -159,7 +159,7 @@ func TestBuildTrafficConfiguration_Vanilla(t *testing.T) {
 			goodNewRev.Name: goodNewRev,
 		},
 	}
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(tts))); err != nil {
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(tts))); err != nil {
 		t.Errorf("Unexpected error %v", err)
 	} else if got, want := tc, expected; !cmp.Equal(want, got, cmpOpts...) {
 		t.Errorf("Unexpected traffic diff (-want +got): %v", cmp.Diff(want, got, cmpOpts...))
@@ -201,7 +201,7 @@ func TestBuildTrafficConfiguration_NoNameRevision(t *testing.T) {
 		Configurations: map[string]*v1.Configuration{goodConfig.Name: goodConfig},
 		Revisions:      map[string]*v1.Revision{goodNewRev.Name: goodNewRev},
 	}
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(tts))); err != nil {
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(tts))); err != nil {
 		t.Errorf("Unexpected error %v", err)
 	} else if got, want := tc, expected; !cmp.Equal(want, got, cmpOpts...) {
 		t.Errorf("Unexpected traffic diff (-want +got): %v", cmp.Diff(want, got, cmpOpts...))
@@ -244,7 +244,7 @@ func TestBuildTrafficConfiguration_VanillaScaledToZero(t *testing.T) {
 			inactiveRev.Name: inactiveRev,
 		},
 	}
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(tts))); err != nil {
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(tts))); err != nil {
 		t.Errorf("Unexpected error %v", err)
 	} else if got, want := tc, expected; !cmp.Equal(want, got, cmpOpts...) {
 		t.Errorf("Unexpected traffic diff (-want +got): %v", cmp.Diff(want, got, cmpOpts...))
@@ -303,7 +303,7 @@ func TestBuildTrafficConfiguration_TwoConfigs(t *testing.T) {
 			niceNewRev.Name: niceNewRev,
 		},
 	}
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
 		ConfigurationName: niceConfig.Name,
 		Percent:           ptr.Int64(90),
 	}, v1.TrafficTarget{
@@ -367,7 +367,7 @@ func TestBuildTrafficConfiguration_Canary(t *testing.T) {
 			goodNewRev.Name: goodNewRev,
 		},
 	}
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
 		RevisionName: goodOldRev.Name,
 		Percent:      ptr.Int64(90),
 	}, v1.TrafficTarget{
@@ -478,7 +478,7 @@ func TestBuildTrafficConfiguration_Consolidated(t *testing.T) {
 			goodNewRev.Name: goodNewRev,
 		},
 	}
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
 		Tag:          "one",
 		RevisionName: goodOldRev.Name,
 		Percent:      ptr.Int64(49),
@@ -548,7 +548,7 @@ func TestBuildTrafficConfiguration_TwoFixedRevisions(t *testing.T) {
 			goodOldRev.Name: goodOldRev,
 		},
 	}
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
 		RevisionName: goodOldRev.Name,
 		Percent:      ptr.Int64(90),
 	}, v1.TrafficTarget{
@@ -613,7 +613,7 @@ func TestBuildTrafficConfiguration_TwoFixedRevisionsFromTwoConfigurations(t *tes
 			niceNewRev.Name: niceNewRev,
 		},
 	}
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
 		RevisionName: goodNewRev.Name,
 		Percent:      ptr.Int64(40),
 	}, v1.TrafficTarget{
@@ -719,7 +719,7 @@ func TestBuildTrafficConfiguration_Preliminary(t *testing.T) {
 			niceNewRev.Name: niceNewRev,
 		},
 	}
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
 		RevisionName: goodOldRev.Name,
 		Percent:      ptr.Int64(100),
 	}, v1.TrafficTarget{
@@ -764,7 +764,7 @@ func TestBuildTrafficConfiguration_MissingConfig(t *testing.T) {
 		Tag:               "alpha",
 		ConfigurationName: missingConfig.Name,
 	}))
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, r); err != nil && expectedErr.Error() != err.Error() {
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, r); err != nil && expectedErr.Error() != err.Error() {
 		t.Errorf("Expected %v, saw %v", expectedErr, err)
 	} else if got, want := tc, expected; !cmp.Equal(want, got, cmpOpts...) {
 		t.Errorf("Unexpected traffic diff (-want +got): %v", cmp.Diff(want, got, cmpOpts...))
@@ -778,7 +778,7 @@ func TestBuildTrafficConfiguration_NotRoutableRevision(t *testing.T) {
 		Revisions:      map[string]*v1.Revision{unreadyRev.Name: unreadyRev},
 	}
 	expectedErr := errUnreadyRevision(unreadyRev)
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
 		RevisionName: unreadyRev.Name,
 		Percent:      ptr.Int64(100),
 	}))); err != nil && expectedErr.Error() != err.Error() {
@@ -795,7 +795,7 @@ func TestBuildTrafficConfiguration_NotRoutableConfiguration(t *testing.T) {
 		Revisions:      map[string]*v1.Revision{},
 	}
 	expectedErr := errUnreadyConfiguration(unreadyConfig)
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
 		ConfigurationName: unreadyConfig.Name,
 		Percent:           ptr.Int64(100),
 	}))); err != nil && expectedErr.Error() != err.Error() {
@@ -815,7 +815,7 @@ func TestBuildTrafficConfiguration_EmptyConfiguration(t *testing.T) {
 	}
 
 	expectedErr := errUnreadyConfiguration(emptyConfig)
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
 		ConfigurationName: emptyConfig.Name,
 		Percent:           ptr.Int64(100),
 	}))); err != nil && expectedErr.Error() != err.Error() {
@@ -835,7 +835,7 @@ func TestBuildTrafficConfiguration_EmptyAndFailedConfigurations(t *testing.T) {
 		Revisions: map[string]*v1.Revision{},
 	}
 	expectedErr := errUnreadyConfiguration(failedConfig)
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
 		ConfigurationName: emptyConfig.Name,
 		Percent:           ptr.Int64(50),
 	}, v1.TrafficTarget{
@@ -858,7 +858,7 @@ func TestBuildTrafficConfiguration_FailedAndEmptyConfigurations(t *testing.T) {
 		Revisions: map[string]*v1.Revision{},
 	}
 	expectedErr := errUnreadyConfiguration(failedConfig)
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
 		ConfigurationName: failedConfig.Name,
 		Percent:           ptr.Int64(50),
 	}, v1.TrafficTarget{
@@ -884,7 +884,7 @@ func TestBuildTrafficConfiguration_MissingRevision(t *testing.T) {
 		}},
 	}
 	expectedErr := errMissingRevision(missingRev.Name)
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, testRouteWithTrafficTargets(WithSpecTraffic(v1.TrafficTarget{
 		RevisionName: missingRev.Name,
 		Percent:      ptr.Int64(50),
 	}, v1.TrafficTarget{
@@ -923,7 +923,7 @@ func TestRoundTripping(t *testing.T) {
 		Tag:               "alpha",
 		ConfigurationName: niceConfig.Name,
 	}))
-	if tc, err := BuildTrafficConfiguration(configLister, revLister, route); err != nil {
+	if tc, err := BuildTrafficConfiguration(getContext(), configLister, revLister, route); err != nil {
 		t.Errorf("Unexpected error %v", err)
 	} else {
 		targets, err := tc.GetRevisionTrafficTargets(getContext(), route)
@@ -936,6 +936,79 @@ func TestRoundTripping(t *testing.T) {
 	}
 }
 
+func TestBuildTrafficConfiguration_GradualRollout(t *testing.T) {
+	route := Route(testNamespace, "test-route",
+		WithRouteLabel(map[string]string{"route": "test-route"}),
+		WithRouteAnnotation(map[string]string{serving.RolloutDurationAnnotationKey: "100"}),
+		WithSpecTraffic(v1.TrafficTarget{
+			ConfigurationName: goodConfig.Name,
+			Percent:           ptr.Int64(100),
+			LatestRevision:    ptr.Bool(true),
+		}),
+		// The Route was last serving the previous Revision of the Configuration.
+		WithStatusTraffic(v1.TrafficTarget{
+			RevisionName:   goodOldRev.Name,
+			Percent:        ptr.Int64(100),
+			LatestRevision: ptr.Bool(true),
+		}))
+
+	// 40% through the rollout window of the new Revision.
+	readyTime := goodNewRev.Status.GetCondition(v1.RevisionConditionReady).LastTransitionTime.Inner.Time
+	now := readyTime.Add(40 * time.Second)
+
+	targets := RevisionTargets{{
+		TrafficTarget: v1.TrafficTarget{
+			ConfigurationName: goodConfig.Name,
+			RevisionName:      goodNewRev.Name,
+			Percent:           ptr.Int64(40),
+			LatestRevision:    ptr.Bool(true),
+		},
+		Active:   true,
+		Protocol: net.ProtocolH2C,
+	}, {
+		TrafficTarget: v1.TrafficTarget{
+			ConfigurationName: goodConfig.Name,
+			RevisionName:      goodOldRev.Name,
+			Percent:           ptr.Int64(60),
+			LatestRevision:    ptr.Bool(false),
+		},
+		Active:   true,
+		Protocol: net.ProtocolHTTP1,
+	}}
+	expected := &Config{
+		Targets: map[string]RevisionTargets{
+			DefaultTarget: targets,
+		},
+		revisionTargets: targets,
+		Configurations: map[string]*v1.Configuration{
+			goodConfig.Name: goodConfig,
+		},
+		Revisions: map[string]*v1.Revision{
+			goodNewRev.Name: goodNewRev,
+			goodOldRev.Name: goodOldRev,
+		},
+		InRollout: true,
+	}
+	if tc, err := buildTrafficConfiguration(getContext(), configLister, revLister, route, now); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	} else if got, want := tc, expected; !cmp.Equal(want, got, cmpOpts...) {
+		t.Errorf("Unexpected traffic diff (-want +got): %v", cmp.Diff(want, got, cmpOpts...))
+	}
+
+	// Once the rollout window has passed, the new Revision takes all traffic.
+	now = readyTime.Add(200 * time.Second)
+	if tc, err := buildTrafficConfiguration(getContext(), configLister, revLister, route, now); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	} else {
+		if tc.InRollout {
+			t.Error("InRollout = true, want: false")
+		}
+		if got, want := len(tc.Targets[DefaultTarget]), 1; got != want {
+			t.Errorf("len(Targets) = %d, want: %d", got, want)
+		}
+	}
+}
+
 func testConfig(name string) *v1.Configuration {
 	return &v1.Configuration{
 		ObjectMeta: metav1.ObjectMeta{
@@ -994,7 +1067,7 @@ func getTestFailedConfig(name string) (*v1.Configuration, *v1.Revision) {
 	config := testConfig(name + "-config")
 	rev := testRevForConfig(config, name+"-revision")
 	config.Status.SetLatestCreatedRevisionName(rev.Name)
-	config.Status.MarkLatestCreatedFailed(rev.Name, "Permanently failed")
+	config.Status.MarkLatestCreatedFailed(rev.Name, "RevisionFailed", "Permanently failed")
 	rev.Status.MarkContainerHealthyFalse(v1.ReasonContainerMissing, "Should have used ko")
 	return config, rev
 }
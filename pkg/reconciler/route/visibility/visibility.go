@@ -18,6 +18,7 @@ package visibility
 
 import (
 	"context"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apilabels "k8s.io/apimachinery/pkg/labels"
@@ -31,6 +32,7 @@ import (
 	"knative.dev/serving/pkg/reconciler/route/domains"
 	"knative.dev/serving/pkg/reconciler/route/resources/labels"
 	"knative.dev/serving/pkg/reconciler/route/traffic"
+	"knative.dev/serving/pkg/resources"
 )
 
 // Resolver resolves the visibility of traffic targets, based on both the Route and placeholder Services labels.
@@ -63,8 +65,16 @@ func (b *Resolver) getServices(route *v1.Route) (map[string]*corev1.Service, err
 }
 
 func (b *Resolver) routeVisibility(ctx context.Context, route *v1.Route) netv1alpha1.IngressVisibility {
+	// A Route can opt out of its default external hostname entirely, staying
+	// reachable on the cluster-local domain and through DomainMappings only.
+	if strings.EqualFold(route.Annotations[serving.NoDefaultDomainAnnotationKey], "true") {
+		return netv1alpha1.IngressVisibilityClusterLocal
+	}
 	domainConfig := config.FromContext(ctx).Domain
-	domain := domainConfig.LookupDomainForLabels(route.Labels)
+	// Match the same set of labels the domain lookup uses elsewhere,
+	// including the Route's namespace.
+	domain := domainConfig.LookupDomainForLabels(resources.UnionMaps(
+		route.Labels, map[string]string{serving.RouteNamespaceLabelKey: route.Namespace}))
 	if domain == "svc."+network.GetClusterDomainName() {
 		return netv1alpha1.IngressVisibilityClusterLocal
 	}
@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	listers "k8s.io/client-go/listers/core/v1"
+	"knative.dev/serving/pkg/apis/networking"
 	netv1alpha1 "knative.dev/serving/pkg/apis/networking/v1alpha1"
 	"knative.dev/serving/pkg/apis/serving"
 	v1 "knative.dev/serving/pkg/apis/serving/v1"
@@ -84,6 +85,51 @@ func TestVisibility(t *testing.T) {
 		expected: map[string]netv1alpha1.IngressVisibility{
 			traffic.DefaultTarget: netv1alpha1.IngressVisibilityClusterLocal,
 		},
+	}, {
+		name: "no tag, default domain disabled via annotation",
+		route: &v1.Route{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "foo",
+				Annotations: map[string]string{
+					serving.NoDefaultDomainAnnotationKey: "true",
+				},
+			},
+		},
+		expected: map[string]netv1alpha1.IngressVisibility{
+			traffic.DefaultTarget: netv1alpha1.IngressVisibilityClusterLocal,
+		},
+	}, {
+		name: "no tag, route marked local via networking label",
+		route: &v1.Route{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "foo",
+				Labels: map[string]string{
+					networking.VisibilityLabelKey: serving.VisibilityClusterLocal,
+				},
+			},
+		},
+		expected: map[string]netv1alpha1.IngressVisibility{
+			traffic.DefaultTarget: netv1alpha1.IngressVisibilityClusterLocal,
+		},
+	}, {
+		name: "no tag, svc marked local via networking label",
+		route: &v1.Route{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "foo",
+			},
+		},
+		services: []*corev1.Service{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "foo",
+				Labels: map[string]string{
+					serving.RouteLabelKey:         "foo",
+					networking.VisibilityLabelKey: serving.VisibilityClusterLocal,
+				},
+			},
+		}},
+		expected: map[string]netv1alpha1.IngressVisibility{
+			traffic.DefaultTarget: netv1alpha1.IngressVisibilityClusterLocal,
+		},
 	}, {
 		name: "no tag, svc marked local",
 		route: &v1.Route{
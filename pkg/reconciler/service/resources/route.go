@@ -21,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/ptr"
 	"knative.dev/serving/pkg/apis/serving"
 	v1 "knative.dev/serving/pkg/apis/serving/v1"
 	"knative.dev/serving/pkg/reconciler/service/resources/names"
@@ -47,6 +48,17 @@ func MakeRoute(service *v1.Service) (*v1.Route, error) {
 		Spec: *service.Spec.RouteSpec.DeepCopy(),
 	}
 
+	// A rollback annotation overrides the Service's traffic spec and pins
+	// the Route to the named Revision until the annotation is removed.
+	if target := service.Annotations[serving.RollbackToAnnotationKey]; target != "" {
+		c.Spec.Traffic = []v1.TrafficTarget{{
+			RevisionName:   target,
+			Percent:        ptr.Int64(100),
+			LatestRevision: ptr.Bool(false),
+		}}
+		return c, nil
+	}
+
 	// Fill in any missing ConfigurationName fields when translating
 	// from Service to Route.
 	for idx := range c.Spec.Traffic {
@@ -62,6 +62,23 @@ func TestRouteSpec(t *testing.T) {
 	}
 }
 
+func TestRouteSpecWithRollbackAnnotation(t *testing.T) {
+	s := createService()
+	s.Annotations = map[string]string{serving.RollbackToAnnotationKey: "rollback-target"}
+	r, err := MakeRoute(s)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantT := []v1.TrafficTarget{{
+		RevisionName:   "rollback-target",
+		Percent:        ptr.Int64(100),
+		LatestRevision: ptr.Bool(false),
+	}}
+	if got, want := r.Spec.Traffic, wantT; !cmp.Equal(got, want) {
+		t.Errorf("Traffic mismatch: diff (-got, +want): %s", cmp.Diff(got, want))
+	}
+}
+
 func TestRouteHasNoKubectlAnnotation(t *testing.T) {
 	s := createServiceWithKubectlAnnotation()
 	r, err := MakeRoute(s)
@@ -101,6 +101,14 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, service *v1.Service) pkg
 		return nil
 	}
 
+	// When a rollback is requested through the annotation, verify the target
+	// Revision before the Route is reprogrammed to it.
+	if target := service.Annotations[serving.RollbackToAnnotationKey]; target != "" {
+		if err := c.validateRollback(ctx, service, config, target); err != nil {
+			return err
+		}
+	}
+
 	route, err := c.route(ctx, logger, service)
 	if err != nil {
 		return err
@@ -169,6 +177,37 @@ func (c *Reconciler) route(ctx context.Context, logger *zap.SugaredLogger, servi
 	return route, nil
 }
 
+// validateRollback checks that the Revision named by the rollback-to
+// annotation exists and is owned by our Configuration, and records the
+// event trail when traffic is about to be rewritten.
+func (c *Reconciler) validateRollback(ctx context.Context, service *v1.Service, config *v1.Configuration, target string) error {
+	recorder := controller.GetEventRecorder(ctx)
+	rev, err := c.revisionLister.Revisions(service.Namespace).Get(target)
+	if apierrs.IsNotFound(err) {
+		recorder.Eventf(service, corev1.EventTypeWarning, "RollbackFailed", "Revision %q does not exist", target)
+		return fmt.Errorf("failed to roll back to Revision: %w", err)
+	} else if err != nil {
+		return fmt.Errorf("failed to get Revision: %w", err)
+	}
+	if !metav1.IsControlledBy(rev, config) {
+		recorder.Eventf(service, corev1.EventTypeWarning, "RollbackFailed", "Revision %q is not owned by Configuration %q", target, config.Name)
+		return fmt.Errorf("service: %q cannot roll back to revision: %q", service.Name, target)
+	}
+
+	// Only record the rollback while the Route is not yet pinned to the
+	// target, so the event trail captures each traffic rewrite once.
+	route, err := c.routeLister.Routes(service.Namespace).Get(resourcenames.Route(service))
+	if apierrs.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get Route: %w", err)
+	}
+	if tt := route.Spec.Traffic; len(tt) != 1 || tt[0].RevisionName != target {
+		recorder.Eventf(service, corev1.EventTypeNormal, "RollingBack", "Rewriting traffic to Revision %q", target)
+	}
+	return nil
+}
+
 func (c *Reconciler) checkRoutesNotReady(config *v1.Configuration, logger *zap.SugaredLogger, route *v1.Route, service *v1.Service) {
 	// `manual` is not reconciled.
 	rc := service.Status.GetCondition(v1.ServiceConditionRoutesReady)
@@ -350,6 +350,48 @@ func TestReconcile(t *testing.T) {
 			Object: route("update-child-labels-ignore-route-label", "foo", WithRunLatestRollout, WithRouteLabel(map[string]string{"new-label": "new-value",
 				"serving.knative.dev/service": "update-child-labels-ignore-route-label"})),
 		}},
+	}, {
+		Name: "rollback annotation rewrites traffic",
+		Objects: []runtime.Object{
+			DefaultService("rollback", "foo",
+				withRollbackTo("rollback-target"), WithInitSvcConditions),
+			config("rollback", "foo", withRollbackTo("rollback-target")),
+			// The Route still carries the pre-rollback traffic.
+			route("rollback", "foo", WithRunLatestRollout),
+			&v1.Revision{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rollback-target",
+					Namespace: "foo",
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: "serving.knative.dev/v1",
+						Kind:       "Configuration",
+						Name:       "rollback",
+						Controller: ptr.Bool(true),
+					}},
+				},
+			},
+		},
+		Key: "foo/rollback",
+		WantUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: route("rollback", "foo", withRollbackTo("rollback-target")),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "RollingBack", "Rewriting traffic to Revision %q", "rollback-target"),
+		},
+	}, {
+		Name: "rollback annotation names a missing revision",
+		Objects: []runtime.Object{
+			DefaultService("rollback", "foo",
+				withRollbackTo("no-such-revision"), WithInitSvcConditions),
+			config("rollback", "foo", withRollbackTo("no-such-revision")),
+		},
+		Key:     "foo/rollback",
+		WantErr: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "RollbackFailed", "Revision %q does not exist", "no-such-revision"),
+			Eventf(corev1.EventTypeWarning, "InternalError",
+				`failed to roll back to Revision: revision.serving.knative.dev "no-such-revision" not found`),
+		},
 	}, {
 		Name: "bad configuration update",
 		Objects: []runtime.Object{
@@ -593,7 +635,7 @@ func TestReconcile(t *testing.T) {
 				}), MarkTrafficAssigned, MarkIngressReady),
 			config("config-fails", "foo", WithRunLatestRollout, WithGeneration(2),
 				WithLatestReady("config-fails-00001"), WithLatestCreated("config-fails-00002"),
-				MarkLatestCreatedFailed("blah"), WithObservedGen),
+				MarkLatestCreatedFailed("RevisionFailed", "blah"), WithObservedGen),
 		},
 		Key: "foo/config-fails",
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
@@ -613,7 +655,7 @@ func TestReconcile(t *testing.T) {
 			DefaultService("config-fails", "foo", WithRunLatestRollout, WithInitSvcConditions),
 			route("config-fails", "foo", WithRunLatestRollout, RouteReady),
 			config("config-fails", "foo", WithRunLatestRollout, WithGeneration(1), WithObservedGen,
-				WithLatestCreated("config-fails-00001"), MarkLatestCreatedFailed("blah")),
+				WithLatestCreated("config-fails-00001"), MarkLatestCreatedFailed("RevisionFailed", "blah")),
 		},
 		Key: "foo/config-fails",
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
@@ -838,6 +880,18 @@ func RouteFailed(reason, message string) RouteOption {
 	}
 }
 
+// withRollbackTo is a runLatest Service whose rollback-to annotation names
+// the given Revision.
+func withRollbackTo(revName string) ServiceOption {
+	return func(svc *v1.Service) {
+		WithRunLatestRollout(svc)
+		if svc.Annotations == nil {
+			svc.Annotations = make(map[string]string)
+		}
+		svc.Annotations[serving.RollbackToAnnotationKey] = revName
+	}
+}
+
 func rev(name, namespace string, so ServiceOption, co ...ConfigOption) *v1.Revision {
 	cfg := config(name, namespace, so, co...)
 	return configresources.MakeRevision(cfg)
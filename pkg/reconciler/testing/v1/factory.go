@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	fakecachingclient "knative.dev/caching/pkg/client/injection/client/fake"
 	fakekubeclient "knative.dev/pkg/client/injection/kube/client/fake"
@@ -33,11 +34,13 @@ import (
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	logtesting "knative.dev/pkg/logging/testing"
+	"knative.dev/pkg/system"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	ktesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/record"
 
@@ -74,6 +77,9 @@ func MakeFactory(ctor Ctor) rtesting.Factory {
 		ctx, cachingClient := fakecachingclient.With(ctx, ls.GetCachingObjects()...)
 		ctx, certManagerClient := fakecertmanagerclient.With(ctx, ls.GetCMCertificateObjects()...)
 		ctx = context.WithValue(ctx, TrackerKey, &rtesting.FakeTracker{})
+		if ctx.Value(ClockKey) == nil {
+			ctx = context.WithValue(ctx, ClockKey, rtesting.FakeClock{Time: FakeTime})
+		}
 
 		// The dynamic client's support for patching is BS.  Implement it
 		// here via PrependReactor (this can be overridden below by the
@@ -168,6 +174,22 @@ type key struct{}
 // TrackerKey is used to looking a FakeTracker in a context.Context
 var TrackerKey key = struct{}{}
 
+type clockKey struct{}
+
+// ClockKey is used to look up the system.Clock in a context.Context
+var ClockKey clockKey = struct{}{}
+
+// FakeTime is the fixed instant the clock injected by MakeFactory reports.
+// A fixed instant keeps time-based conditions deterministic, without real
+// sleeps or time.Now() races.
+var FakeTime = time.Unix(1e9, 0)
+
+// GetClock returns the system.Clock from the context. Tests can override
+// the default fake clock by presetting TableRow.Ctx.
+func GetClock(ctx context.Context) system.Clock {
+	return ctx.Value(ClockKey).(system.Clock)
+}
+
 // AssertTrackingConfig will ensure the provided Configuration is being tracked
 func AssertTrackingConfig(namespace, name string) func(*testing.T, *rtesting.TableRow) {
 	gvk := v1.SchemeGroupVersion.WithKind("Configuration")
@@ -201,3 +223,28 @@ func AssertTrackingObject(gvk schema.GroupVersionKind, namespace, name string) f
 	}
 
 }
+
+// AssertPatchType will ensure every patch of the named object recorded
+// through the serving client used the given patch type. Combined with
+// WantPatches this lets tests assert how a patch was issued (e.g.
+// types.ApplyPatchType for server-side apply), not just its payload.
+func AssertPatchType(name string, pt types.PatchType) func(*testing.T, *rtesting.TableRow) {
+	return func(t *testing.T, r *rtesting.TableRow) {
+		t.Helper()
+		client := fakeservingclient.Get(r.Ctx)
+		found := false
+		for _, a := range client.Actions() {
+			pa, ok := a.(ktesting.PatchAction)
+			if !ok || pa.GetName() != name {
+				continue
+			}
+			found = true
+			if got, want := pa.GetPatchType(), pt; got != want {
+				t.Errorf("Patch type for %q = %v, want: %v", name, got, want)
+			}
+		}
+		if !found {
+			t.Errorf("No patches for %q were recorded", name)
+		}
+	}
+}
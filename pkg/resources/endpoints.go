@@ -39,6 +39,17 @@ func NotReadyAddressCount(endpoints *corev1.Endpoints) int {
 	return notReady
 }
 
+// ReadyAddresses returns the list of ready IP addresses for the given endpoint.
+func ReadyAddresses(endpoints *corev1.Endpoints) []string {
+	addresses := make([]string, 0, ReadyAddressCount(endpoints))
+	for _, subset := range endpoints.Subsets {
+		for _, address := range subset.Addresses {
+			addresses = append(addresses, address.IP)
+		}
+	}
+	return addresses
+}
+
 // EndpointsCounter provides a count of currently ready and notReady pods. This
 // information is used by UniScaler implementations to make scaling
 // decisions. The interface prevents the UniScaler from needing to
@@ -52,6 +63,14 @@ type EndpointsCounter interface {
 	NotReadyCount() (int, error)
 }
 
+// EndpointsAddressGetter provides the IP addresses of the currently ready
+// pods, so callers can reach them directly instead of going through the
+// service. The interface prevents the caller from needing to know how the
+// addresses are retrieved.
+type EndpointsAddressGetter interface {
+	ReadyAddresses() ([]string, error)
+}
+
 type scopedEndpointCounter struct {
 	endpointsLister corev1listers.EndpointsLister
 	namespace       string
@@ -74,6 +93,14 @@ func (eac *scopedEndpointCounter) NotReadyCount() (int, error) {
 	return NotReadyAddressCount(endpoints), nil
 }
 
+func (eac *scopedEndpointCounter) ReadyAddresses() ([]string, error) {
+	endpoints, err := eac.endpointsLister.Endpoints(eac.namespace).Get(eac.serviceName)
+	if err != nil {
+		return nil, err
+	}
+	return ReadyAddresses(endpoints), nil
+}
+
 // NewScopedEndpointsCounter creates a EndpointsCounter that uses
 // a count of endpoints for a namespace/serviceName as the value
 // of ready pods. The values returned by ReadyCount() will vary
@@ -87,3 +114,15 @@ func NewScopedEndpointsCounter(lister corev1listers.EndpointsLister, namespace,
 		serviceName:     serviceName,
 	}
 }
+
+// NewScopedEndpointsAddressGetter creates an EndpointsAddressGetter that
+// returns the ready IP addresses of the endpoints for namespace/serviceName.
+// lister is used to retrieve the endpoints with the scope of
+// namespace/serviceName.
+func NewScopedEndpointsAddressGetter(lister corev1listers.EndpointsLister, namespace, serviceName string) EndpointsAddressGetter {
+	return &scopedEndpointCounter{
+		endpointsLister: lister,
+		namespace:       namespace,
+		serviceName:     serviceName,
+	}
+}
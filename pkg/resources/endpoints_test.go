@@ -129,6 +129,19 @@ func TestReadyAddressCount(t *testing.T) {
 	}
 }
 
+func TestReadyAddresses(t *testing.T) {
+	want := []string{"127.0.0.1", "127.0.0.4", "127.0.0.7"}
+	got := ReadyAddresses(endpoints(3, 2))
+	if len(got) != len(want) {
+		t.Fatalf("ReadyAddresses() = %v, want: %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ReadyAddresses()[%d] = %q, want: %q", i, got[i], want[i])
+		}
+	}
+}
+
 func endpoints(readyIPCount, notReadyIPCount int) *corev1.Endpoints {
 	ep := &corev1.Endpoints{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package storageversion rewrites stored custom resources at their
+// definition's current storage version, so that older API versions can be
+// dropped from the CRD's stored version list.
+package storageversion
+
+import (
+	"fmt"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apixclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+// Migrator migrates stored objects to their definition's storage version.
+type Migrator struct {
+	dynamicClient dynamic.Interface
+	apixClient    apixclient.Interface
+}
+
+// NewMigrator returns a Migrator using the given clients.
+func NewMigrator(d dynamic.Interface, a apixclient.Interface) *Migrator {
+	return &Migrator{
+		dynamicClient: d,
+		apixClient:    a,
+	}
+}
+
+// Migrate rewrites all objects of the given resource at the CRD's current
+// storage version and then prunes status.storedVersions down to just that
+// version. Any webhook conversions happen server side as part of the rewrite.
+func (m *Migrator) Migrate(gr schema.GroupResource) error {
+	crdClient := m.apixClient.ApiextensionsV1beta1().CustomResourceDefinitions()
+
+	crd, err := crdClient.Get(gr.String(), metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to fetch CRD %s: %w", gr, err)
+	}
+
+	version, err := storageVersion(crd)
+	if err != nil {
+		return err
+	}
+
+	if err := m.migrateResources(gr.WithVersion(version)); err != nil {
+		return err
+	}
+
+	crd.Status.StoredVersions = []string{version}
+	if _, err := crdClient.UpdateStatus(crd); err != nil {
+		return fmt.Errorf("unable to update CRD %s status: %w", gr, err)
+	}
+	return nil
+}
+
+func (m *Migrator) migrateResources(gvr schema.GroupVersionResource) error {
+	client := m.dynamicClient.Resource(gvr)
+
+	var continueToken string
+	for {
+		list, err := client.Namespace(metav1.NamespaceAll).List(metav1.ListOptions{
+			Continue: continueToken,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to list %s: %w", gvr, err)
+		}
+
+		for i := range list.Items {
+			item := &list.Items[i]
+			// An empty patch makes the API server read the object and write
+			// it back at the storage version.
+			_, err := client.Namespace(item.GetNamespace()).Patch(
+				item.GetName(), types.MergePatchType, []byte("{}"), metav1.PatchOptions{})
+			if err != nil && !apierrs.IsNotFound(err) {
+				return fmt.Errorf("unable to patch %s %s/%s: %w",
+					gvr, item.GetNamespace(), item.GetName(), err)
+			}
+		}
+
+		continueToken = list.GetContinue()
+		if continueToken == "" {
+			return nil
+		}
+	}
+}
+
+func storageVersion(crd *v1beta1.CustomResourceDefinition) (string, error) {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return v.Name, nil
+		}
+	}
+	// Older definitions only set the singular version field.
+	if crd.Spec.Version != "" {
+		return crd.Spec.Version, nil
+	}
+	return "", fmt.Errorf("unable to determine the storage version of CRD %s", crd.Name)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storageversion
+
+import (
+	"testing"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	clientgotesting "k8s.io/client-go/testing"
+)
+
+var servicesGVR = schema.GroupVersionResource{
+	Group:    "serving.knative.dev",
+	Version:  "v1",
+	Resource: "services",
+}
+
+func TestMigrateResources(t *testing.T) {
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(),
+		unstructuredService("default", "first"),
+		unstructuredService("other", "second"),
+	)
+	migrator := NewMigrator(dynamicClient, nil)
+
+	if err := migrator.migrateResources(servicesGVR); err != nil {
+		t.Fatalf("migrateResources() = %v", err)
+	}
+
+	patched := make(map[string]bool)
+	for _, action := range dynamicClient.Actions() {
+		if action.GetVerb() != "patch" {
+			continue
+		}
+		patch := action.(clientgotesting.PatchAction)
+		patched[patch.GetNamespace()+"/"+patch.GetName()] = true
+	}
+	for _, want := range []string{"default/first", "other/second"} {
+		if !patched[want] {
+			t.Errorf("Service %s was not patched, actions: %v", want, dynamicClient.Actions())
+		}
+	}
+}
+
+func TestStorageVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    v1beta1.CustomResourceDefinitionSpec
+		want    string
+		wantErr bool
+	}{{
+		name: "from versions list",
+		spec: v1beta1.CustomResourceDefinitionSpec{
+			Versions: []v1beta1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Storage: false},
+				{Name: "v1", Storage: true},
+			},
+		},
+		want: "v1",
+	}, {
+		name: "from legacy version field",
+		spec: v1beta1.CustomResourceDefinitionSpec{
+			Version: "v1alpha1",
+		},
+		want: "v1alpha1",
+	}, {
+		name:    "no storage version",
+		spec:    v1beta1.CustomResourceDefinitionSpec{},
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			crd := &v1beta1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "services.serving.knative.dev"},
+				Spec:       test.spec,
+			}
+			got, err := storageVersion(crd)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("storageVersion() = %v, wantErr: %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("storageVersion() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func unstructuredService(namespace, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "serving.knative.dev/v1",
+		"kind":       "Service",
+		"metadata": map[string]interface{}{
+			"namespace": namespace,
+			"name":      name,
+		},
+	}}
+}
@@ -81,9 +81,16 @@ func WithPodAutoscalerOwnersRemoved(r *asv1a1.PodAutoscaler) {
 
 // WithTraffic updates the PA to reflect it receiving traffic.
 func WithTraffic(pa *asv1a1.PodAutoscaler) {
+	pa.Status.MarkScaleTargetInitialized()
 	pa.Status.MarkActive()
 }
 
+// WithScaleTargetInitialized updates the PA to reflect that its scale target
+// has been successfully initialized at least once.
+func WithScaleTargetInitialized(pa *asv1a1.PodAutoscaler) {
+	pa.Status.MarkScaleTargetInitialized()
+}
+
 // WithPAStatusService annotates PA Status with the provided service name.
 func WithPAStatusService(svc string) PodAutoscalerOption {
 	return func(pa *asv1a1.PodAutoscaler) {
@@ -102,6 +109,7 @@ func WithPAMetricsService(svc string) PodAutoscalerOption {
 // and buffered traffic while it is being activated.
 func WithBufferedTraffic(reason, message string) PodAutoscalerOption {
 	return func(pa *asv1a1.PodAutoscaler) {
+		pa.Status.InitializeConditions()
 		pa.Status.MarkActivating(reason, message)
 	}
 }
@@ -110,6 +118,7 @@ func WithBufferedTraffic(reason, message string) PodAutoscalerOption {
 // receiving traffic.
 func WithNoTraffic(reason, message string) PodAutoscalerOption {
 	return func(pa *asv1a1.PodAutoscaler) {
+		pa.Status.InitializeConditions()
 		pa.Status.MarkInactive(reason, message)
 	}
 }
@@ -285,6 +294,22 @@ func WithUnschedulableContainer(reason, message string) PodOption {
 	}
 }
 
+// WithOOMKilledContainer sets the .Status.ContainerStatuses on the pod to
+// indicate that the given container was killed for exceeding its memory limit.
+func WithOOMKilledContainer(name string) PodOption {
+	return func(pod *corev1.Pod) {
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			Name: name,
+			LastTerminationState: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{
+					ExitCode: 137,
+					Reason:   "OOMKilled",
+				},
+			},
+		}}
+	}
+}
+
 // WithWaitingContainer sets the .Status.ContainerStatuses on the pod to
 // include a container named accordingly to wait with the given state.
 func WithWaitingContainer(name, reason, message string) PodOption {
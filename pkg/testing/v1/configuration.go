@@ -90,9 +90,9 @@ func MarkRevisionCreationFailed(msg string) ConfigOption {
 }
 
 // MarkLatestCreatedFailed calls .Status.MarkLatestCreatedFailed.
-func MarkLatestCreatedFailed(msg string) ConfigOption {
+func MarkLatestCreatedFailed(reason, msg string) ConfigOption {
 	return func(cfg *v1.Configuration) {
-		cfg.Status.MarkLatestCreatedFailed(cfg.Status.LatestCreatedRevisionName, msg)
+		cfg.Status.MarkLatestCreatedFailed(cfg.Status.LatestCreatedRevisionName, reason, msg)
 	}
 }
 
@@ -106,6 +106,16 @@ func WithConfigLabel(key, value string) ConfigOption {
 	}
 }
 
+// WithConfigAnn attaches a particular annotation to the configuration.
+func WithConfigAnn(key, value string) ConfigOption {
+	return func(config *v1.Configuration) {
+		if config.Annotations == nil {
+			config.Annotations = make(map[string]string)
+		}
+		config.Annotations[key] = value
+	}
+}
+
 // WithConfigOwnersRemoved clears the owner references of this Configuration.
 func WithConfigOwnersRemoved(cfg *v1.Configuration) {
 	cfg.OwnerReferences = nil
@@ -158,6 +158,13 @@ func MarkContainerExiting(exitCode int32, message string) RevisionOption {
 	}
 }
 
+// MarkContainerUnhealthy calls .Status.MarkContainerHealthyFalse on the Revision.
+func MarkContainerUnhealthy(reason, message string) RevisionOption {
+	return func(r *v1.Revision) {
+		r.Status.MarkContainerHealthyFalse(reason, message)
+	}
+}
+
 // MarkResourcesUnavailable calls .Status.MarkResourcesUnavailable on the Revision.
 func MarkResourcesUnavailable(reason, message string) RevisionOption {
 	return func(r *v1.Revision) {
@@ -182,3 +189,13 @@ func WithRevisionLabel(key, value string) RevisionOption {
 		config.Labels[key] = value
 	}
 }
+
+// WithRevisionAnn attaches a particular annotation to the revision.
+func WithRevisionAnn(key, value string) RevisionOption {
+	return func(rev *v1.Revision) {
+		if rev.Annotations == nil {
+			rev.Annotations = make(map[string]string)
+		}
+		rev.Annotations[key] = value
+	}
+}
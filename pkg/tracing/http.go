@@ -0,0 +1,46 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"net/http"
+
+	"go.opencensus.io/plugin/ochttp"
+)
+
+// defaultFormat propagates both W3C 'traceparent' and B3 headers.
+var defaultFormat = &HTTPFormat{}
+
+// HTTPSpanMiddleware returns an http.Handler creating a span for every
+// request handled by next, joining traces propagated in either the W3C
+// 'traceparent' or the B3 header format.
+func HTTPSpanMiddleware(next http.Handler) http.Handler {
+	return &ochttp.Handler{
+		Propagation: defaultFormat,
+		Handler:     next,
+	}
+}
+
+// Transport wraps base with a RoundTripper creating a span for every
+// outgoing request and propagating its context in both the W3C
+// 'traceparent' and the B3 header formats.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	return &ochttp.Transport{
+		Propagation: defaultFormat,
+		Base:        base,
+	}
+}
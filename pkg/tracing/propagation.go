@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.opencensus.io/plugin/ochttp/propagation/b3"
+	"go.opencensus.io/trace"
+	"go.opencensus.io/trace/propagation"
+)
+
+// TraceparentHeader is the W3C trace context header.
+// See https://www.w3.org/TR/trace-context/ for the format.
+const TraceparentHeader = "traceparent"
+
+// HTTPFormat propagates span contexts in both the W3C 'traceparent' and
+// the B3 header formats.  Incoming requests are read preferring
+// 'traceparent' and falling back to B3; outgoing requests carry both
+// sets of headers so spans are joined regardless of which format the
+// next hop understands.
+type HTTPFormat struct{}
+
+var _ propagation.HTTPFormat = (*HTTPFormat)(nil)
+
+var b3Format = &b3.HTTPFormat{}
+
+// SpanContextFromRequest extracts a span context from incoming requests.
+func (f *HTTPFormat) SpanContextFromRequest(req *http.Request) (trace.SpanContext, bool) {
+	if sc, ok := parseTraceparent(req.Header.Get(TraceparentHeader)); ok {
+		return sc, true
+	}
+	return b3Format.SpanContextFromRequest(req)
+}
+
+// SpanContextToRequest modifies the given request to include 'traceparent'
+// and B3 headers for the given span context.
+func (f *HTTPFormat) SpanContextToRequest(sc trace.SpanContext, req *http.Request) {
+	req.Header.Set(TraceparentHeader, fmt.Sprintf("00-%s-%s-%02x",
+		sc.TraceID, sc.SpanID, byte(sc.TraceOptions)&1))
+	b3Format.SpanContextToRequest(sc, req)
+}
+
+// parseTraceparent parses a 'traceparent' header value of the form
+// version-traceID-spanID-flags, e.g.
+// 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01.
+func parseTraceparent(h string) (trace.SpanContext, bool) {
+	var sc trace.SpanContext
+	parts := strings.Split(h, "-")
+	if len(parts) != 4 || len(parts[0]) != 2 || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return sc, false
+	}
+
+	// Version 0xff is forbidden; future versions must still lead with the
+	// four fields above, so we parse them regardless of the version value.
+	if ver, err := hex.DecodeString(parts[0]); err != nil || ver[0] == 0xff {
+		return sc, false
+	}
+
+	traceID, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return sc, false
+	}
+	copy(sc.TraceID[:], traceID)
+
+	spanID, err := hex.DecodeString(parts[2])
+	if err != nil {
+		return sc, false
+	}
+	copy(sc.SpanID[:], spanID)
+
+	// All-zero trace or span ids are invalid per the specification.
+	if sc.TraceID == (trace.TraceID{}) || sc.SpanID == (trace.SpanID{}) {
+		return sc, false
+	}
+
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil {
+		return sc, false
+	}
+	sc.TraceOptions = trace.TraceOptions(flags[0] & 1)
+
+	return sc, true
+}
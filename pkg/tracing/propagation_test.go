@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opencensus.io/plugin/ochttp/propagation/b3"
+	"go.opencensus.io/trace"
+)
+
+var testSpanContext = trace.SpanContext{
+	TraceID:      trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+	SpanID:       trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+	TraceOptions: trace.TraceOptions(1),
+}
+
+func TestSpanContextFromRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    trace.SpanContext
+		wantOK  bool
+	}{{
+		name: "traceparent",
+		headers: map[string]string{
+			TraceparentHeader: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		},
+		want:   testSpanContext,
+		wantOK: true,
+	}, {
+		name: "traceparent not sampled",
+		headers: map[string]string{
+			TraceparentHeader: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+		},
+		want: trace.SpanContext{
+			TraceID: testSpanContext.TraceID,
+			SpanID:  testSpanContext.SpanID,
+		},
+		wantOK: true,
+	}, {
+		name: "b3 fallback",
+		headers: map[string]string{
+			b3.TraceIDHeader: "4bf92f3577b34da6a3ce929d0e0e4736",
+			b3.SpanIDHeader:  "00f067aa0ba902b7",
+			b3.SampledHeader: "1",
+		},
+		want:   testSpanContext,
+		wantOK: true,
+	}, {
+		name: "malformed traceparent with b3 fallback",
+		headers: map[string]string{
+			TraceparentHeader: "not-a-traceparent",
+			b3.TraceIDHeader:  "4bf92f3577b34da6a3ce929d0e0e4736",
+			b3.SpanIDHeader:   "00f067aa0ba902b7",
+			b3.SampledHeader:  "1",
+		},
+		want:   testSpanContext,
+		wantOK: true,
+	}, {
+		name: "forbidden traceparent version",
+		headers: map[string]string{
+			TraceparentHeader: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		},
+		wantOK: false,
+	}, {
+		name: "all-zero trace id",
+		headers: map[string]string{
+			TraceparentHeader: "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		},
+		wantOK: false,
+	}, {
+		name:   "no headers",
+		wantOK: false,
+	}}
+
+	f := &HTTPFormat{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			got, ok := f.SpanContextFromRequest(req)
+			if ok != tt.wantOK {
+				t.Fatalf("SpanContextFromRequest() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && got != tt.want {
+				t.Errorf("SpanContextFromRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpanContextToRequest(t *testing.T) {
+	f := &HTTPFormat{}
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	f.SpanContextToRequest(testSpanContext, req)
+
+	if got, want := req.Header.Get(TraceparentHeader), "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"; got != want {
+		t.Errorf("%s header = %q, want %q", TraceparentHeader, got, want)
+	}
+	if got, want := req.Header.Get(b3.TraceIDHeader), "4bf92f3577b34da6a3ce929d0e0e4736"; got != want {
+		t.Errorf("%s header = %q, want %q", b3.TraceIDHeader, got, want)
+	}
+
+	// The headers we write must parse back to the same span context.
+	if got, ok := f.SpanContextFromRequest(req); !ok || got != testSpanContext {
+		t.Errorf("SpanContextFromRequest() = %v, %v, want %v, true", got, ok, testSpanContext)
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The chaosduck binary is an e2e testing tool for leader election. It
+// periodically looks up the bucket Leases in the system namespace and
+// kills one of the current leader pods of every control plane
+// component, so e2e tests running alongside it can assert that traffic
+// keeps flowing and resources keep reconciling across leadership
+// changes.
+package main
+
+import (
+	"flag"
+	"log"
+	"math/rand"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"knative.dev/pkg/signals"
+	"knative.dev/pkg/system"
+)
+
+var (
+	masterURL = flag.String("master", "",
+		"The address of the Kubernetes API server. Overrides any value in kubeconfig. Only required if out-of-cluster.")
+	kubeconfig = flag.String("kubeconfig", "",
+		"Path to a kubeconfig. Only required if out-of-cluster.")
+	frequency = flag.Duration("frequency", 30*time.Second,
+		"How often to kill a leader pod of every component.")
+	components = flag.String("components", "",
+		"Comma separated allow list of components to disturb. When empty, every component holding a bucket Lease is fair game.")
+)
+
+// leaders returns the set of Lease holder identities in the system
+// namespace, keyed by the component owning the bucket.
+func leaders(kc kubernetes.Interface) (map[string]sets.String, error) {
+	leases, err := kc.CoordinationV1().Leases(system.Namespace()).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ls := make(map[string]sets.String)
+	for _, l := range leases.Items {
+		idx := strings.Index(l.Name, "-bucket-")
+		if idx < 0 {
+			continue
+		}
+		if l.Spec.HolderIdentity == nil || *l.Spec.HolderIdentity == "" {
+			continue
+		}
+		component := l.Name[:idx]
+		if _, ok := ls[component]; !ok {
+			ls[component] = sets.NewString()
+		}
+		ls[component].Insert(*l.Spec.HolderIdentity)
+	}
+	return ls, nil
+}
+
+// podForIdentity resolves a Lease holder identity to a pod name. The
+// controller buckets use the pod name as identity, the autoscaler
+// buckets use the pod IP.
+func podForIdentity(pods *corev1.PodList, identity string) string {
+	for _, p := range pods.Items {
+		if p.Name == identity || p.Status.PodIP == identity {
+			return p.Name
+		}
+	}
+	return ""
+}
+
+// quack kills one randomly chosen leader pod of every allowed component.
+func quack(kc kubernetes.Interface, allowed sets.String) error {
+	ls, err := leaders(kc)
+	if err != nil {
+		return err
+	}
+	pods, err := kc.CoreV1().Pods(system.Namespace()).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for component, holders := range ls {
+		if allowed.Len() > 0 && !allowed.Has(component) {
+			continue
+		}
+		identity := holders.UnsortedList()[rand.Intn(holders.Len())]
+		name := podForIdentity(pods, identity)
+		if name == "" {
+			log.Printf("No pod found for %s leader %q", component, identity)
+			continue
+		}
+		log.Printf("Killing %s leader %q", component, name)
+		if err := kc.CoreV1().Pods(system.Namespace()).Delete(name, &metav1.DeleteOptions{}); err != nil && !apierrs.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+
+	cfg, err := clientcmd.BuildConfigFromFlags(*masterURL, *kubeconfig)
+	if err != nil {
+		log.Fatal("Failed to build kubeconfig: ", err)
+	}
+	kc := kubernetes.NewForConfigOrDie(cfg)
+
+	allowed := sets.NewString()
+	for _, c := range strings.Split(*components, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			allowed.Insert(c)
+		}
+	}
+
+	stopCh := signals.SetupSignalHandler()
+	ticker := time.NewTicker(*frequency)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			if err := quack(kc, allowed); err != nil {
+				log.Print("Failed to disturb the leaders: ", err)
+			}
+		}
+	}
+}
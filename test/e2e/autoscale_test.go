@@ -58,7 +58,11 @@ const (
 	containerConcurrency = 6.0
 	targetUtilization    = 0.7
 	successRateSLO       = 0.999
-	autoscaleSleep       = 500
+	// latencyP95SLO is deliberately generous: it is meant to catch requests
+	// that sat in a queue for a long time because the deployment scaled up
+	// too slowly, while still tolerating the occasional cold start.
+	latencyP95SLO  = 10 * time.Second
+	autoscaleSleep = 500
 
 	wsHostnameTestImageName = "wsserver-hostname"
 	autoscaleTestImageName  = "autoscale"
@@ -118,36 +122,35 @@ func generateTraffic(
 	results := attacker.Attack(vegeta.NewStaticTargeter(target), pacer, duration, "load-test")
 	defer attacker.Stop()
 
-	var (
-		totalRequests      int32
-		successfulRequests int32
-	)
+	metrics := &vegeta.Metrics{}
+	checkSLO := func() error {
+		metrics.Close()
+		if metrics.Requests > 0 && metrics.Success < successRateSLO {
+			return fmt.Errorf("request success rate under SLO: total = %d, errors = %v, rate = %f, SLO = %f",
+				metrics.Requests, metrics.Errors, metrics.Success, successRateSLO)
+		}
+		if metrics.Latencies.P95 > latencyP95SLO {
+			return fmt.Errorf("request p95 latency over SLO: p95 = %v, SLO = %v",
+				metrics.Latencies.P95, latencyP95SLO)
+		}
+		return nil
+	}
 	for {
 		select {
 		case <-stopChan:
 			ctx.t.Log("Stopping generateTraffic")
-			successRate := float64(1)
-			if totalRequests > 0 {
-				successRate = float64(successfulRequests) / float64(totalRequests)
-			}
-			if successRate < successRateSLO {
-				return fmt.Errorf("request success rate under SLO: total = %d, errors = %d, rate = %f, SLO = %f",
-					totalRequests, totalRequests-successfulRequests, successRate, successRateSLO)
-			}
-			return nil
+			return checkSLO()
 		case res, ok := <-results:
 			if !ok {
 				ctx.t.Log("Time is up; done")
-				return nil
+				return checkSLO()
 			}
 
-			totalRequests++
+			metrics.Add(res)
 			if res.Code != http.StatusOK {
 				ctx.t.Logf("Status = %d, want: 200", res.Code)
 				ctx.t.Logf("URL: %s Duration: %v Body:\n%s", res.URL, res.Latency, string(res.Body))
-				continue
 			}
-			successfulRequests++
 		}
 	}
 }